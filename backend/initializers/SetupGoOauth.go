@@ -2,44 +2,48 @@ package initializers
 
 import (
 	"os"
+	"strings"
 
-	"golang.org/x/oauth2"
-	"golang.org/x/oauth2/google"
+	"github.com/DAF-Bridge/asaiasa-Backend/logs"
+	"github.com/DAF-Bridge/asaiasa-Backend/pkg/oauthprovider"
 )
 
-var OauthConfig *oauth2.Config
-var OauthConfigAdmin *oauth2.Config
+var OAuthProviders oauthprovider.Registry
+var AdminOAuthProviders oauthprovider.Registry
 
+// InitOAuth enables every provider in oauthprovider.Definitions whose
+// <NAME>_CLIENT_ID and <NAME>_CLIENT_SECRET are set. <NAME>_AUTH_URL,
+// <NAME>_TOKEN_URL and <NAME>_USERINFO_URL override the provider endpoints.
 func InitOAuth() {
-	ClientID := os.Getenv("GOOGLE_CLIENT_ID")
-	ClientSecret := os.Getenv("GOOGLE_CLIENT_SECRET")
-
 	// Use FRONTEND_URL for OAuth redirect (where the React app handles the callback)
 	FrontendURL := os.Getenv("FRONTEND_URL")
 	if FrontendURL == "" {
 		FrontendURL = os.Getenv("BASE_EXTERNAL_URL") // Fallback to BASE_EXTERNAL_URL
 	}
-	RedirectURL := FrontendURL + "/auth/google/callback"
-
-	OauthConfig = &oauth2.Config{
-		ClientID:     ClientID,
-		ClientSecret: ClientSecret,
-		RedirectURL:  RedirectURL,
-		Scopes:       []string{"email", "profile"},
-		Endpoint:     google.Endpoint,
-	}
+
+	OAuthProviders = newOAuthRegistry(FrontendURL + "/auth/")
+	AdminOAuthProviders = newOAuthRegistry(os.Getenv("ADMIN_EXTERNAL_URL") + "/admin/auth/")
 }
 
-func InitAdminOAuth() {
-	ClientID := os.Getenv("GOOGLE_CLIENT_ID")
-	ClientSecret := os.Getenv("GOOGLE_CLIENT_SECRET")
-	RedirectURL := os.Getenv("ADMIN_EXTERNAL_URL") + "/admin/auth/google/callback"
-
-	OauthConfigAdmin = &oauth2.Config{
-		ClientID:     ClientID,
-		ClientSecret: ClientSecret,
-		RedirectURL:  RedirectURL,
-		Scopes:       []string{"email", "profile"},
-		Endpoint:     google.Endpoint,
+func newOAuthRegistry(callbackBaseURL string) oauthprovider.Registry {
+	registry := oauthprovider.Registry{}
+	for name, definition := range oauthprovider.Definitions {
+		prefix := strings.ToUpper(name) + "_"
+		credentials := oauthprovider.Credentials{
+			ClientID:     os.Getenv(prefix + "CLIENT_ID"),
+			ClientSecret: os.Getenv(prefix + "CLIENT_SECRET"),
+			RedirectURL:  callbackBaseURL + name + "/callback",
+			AuthURL:      os.Getenv(prefix + "AUTH_URL"),
+			TokenURL:     os.Getenv(prefix + "TOKEN_URL"),
+			UserInfoURL:  os.Getenv(prefix + "USERINFO_URL"),
+		}
+		if credentials.ClientID == "" || credentials.ClientSecret == "" {
+			logs.Warn("OAuth provider " + name + " is disabled: " + prefix + "CLIENT_ID or " + prefix + "CLIENT_SECRET is not set")
+			continue
+		}
+
+		registry[name] = oauthprovider.New(name, definition, credentials)
 	}
+
+	return registry
 }
@@ -14,6 +14,15 @@ var (
 	DialerMail            *gomail.Dialer
 	InviteBodyTemplate    *template.Template
 	BaseCallbackInviteURL string
+
+	VerifyEmailTemplate          *template.Template
+	ResetPasswordTemplate        *template.Template
+	BaseCallbackVerifyEmailURL   string
+	BaseCallbackResetPasswordURL string
+
+	SavedSearchDigestTemplate *template.Template
+	BaseWebURL                string
+	BaseUnsubscribeDigestURL  string
 )
 
 func SetupInviteMail() {
@@ -31,6 +40,41 @@ func SetupInviteMail() {
 
 }
 
+func SetupAuthMail() {
+	verifyEmailTemplate, err := template.ParseFiles("./Verify_email_template.html")
+	if err != nil {
+		log.Fatalf("Error loading template: %v", err)
+	}
+	resetPasswordTemplate, err := template.ParseFiles("./Reset_password_template.html")
+	if err != nil {
+		log.Fatalf("Error loading template: %v", err)
+	}
+	VerifyEmailTemplate = verifyEmailTemplate
+	ResetPasswordTemplate = resetPasswordTemplate
+	baseUrl := os.Getenv("BASE_EXTERNAL_URL")
+	if baseUrl == "" {
+		log.Fatal("BASE_EXTERNAL_URL is not set")
+	}
+	BaseCallbackVerifyEmailURL = baseUrl + "/verify-email?token="
+	BaseCallbackResetPasswordURL = baseUrl + "/reset-password?token="
+	logs.Info("Successfully Setup Auth Mail")
+}
+
+func SetupSavedSearchMail() {
+	digestTemplate, err := template.ParseFiles("./Saved_search_digest_template.html")
+	if err != nil {
+		log.Fatalf("Error loading template: %v", err)
+	}
+	SavedSearchDigestTemplate = digestTemplate
+	baseUrl := os.Getenv("BASE_EXTERNAL_URL")
+	if baseUrl == "" {
+		log.Fatal("BASE_EXTERNAL_URL is not set")
+	}
+	BaseWebURL = baseUrl
+	BaseUnsubscribeDigestURL = baseUrl + "/saved-searches/unsubscribe?token="
+	logs.Info("Successfully Setup Saved Search Mail")
+}
+
 func SetupMail() {
 	//SMTP_PASSWORD
 	//SMTP_MAIL
@@ -1,13 +1,16 @@
 package app
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
 	"log"
 	"os"
 	"os/exec"
+	"os/signal"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/DAF-Bridge/asaiasa-Backend/errs"
@@ -18,6 +21,9 @@ import (
 
 	"github.com/DAF-Bridge/asaiasa-Backend/initializers"
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/infrastructure/api"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/infrastructure/scheduler"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
 	"github.com/DAF-Bridge/asaiasa-Backend/logs"
 	"github.com/DAF-Bridge/asaiasa-Backend/utils"
 	"github.com/gofiber/fiber/v2"
@@ -37,11 +43,12 @@ func init() {
 	initializers.ConnectToCasbin()
 	initializers.SetupMail()
 	initializers.SetupInviteMail()
+	initializers.SetupAuthMail()
+	initializers.SetupSavedSearchMail()
 	// initializers.ConnectToRedis()
 	// initializers.SyncDB()
 	initializers.SetupGoth()
 	initializers.InitOAuth()
-	initializers.InitAdminOAuth()
 }
 
 func triggerJenkins() {
@@ -90,6 +97,10 @@ func triggerJenkinsRec() {
 func Start() {
 	utils.InitConfig()
 
+	// Cancelled on SIGINT or SIGTERM, which stops the background jobs and the server
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	// Instantiate Goth
 	app := fiber.New(fiber.Config{
 		BodyLimit:    10 * 1024 * 1024, // 10MB body limit
@@ -162,6 +173,8 @@ func Start() {
 	if jwtSecret == "" {
 		log.Fatal("JWT_SECRET is not set")
 	}
+	// One session service backs the auth middleware of every router
+	sessionService := service.NewSessionService(repository.NewSessionRepository(initializers.DB), jwtSecret)
 
 	// Jenkins
 	app.Post("/trigger-jenkins", func(c *fiber.Ctx) error {
@@ -174,24 +187,84 @@ func Start() {
 		return c.SendString("Triggered Jenkins!, Recommendation CD")
 	})
 
-	api.NewRecommendationRouter(app, initializers.DB, jwtSecret)
+	api.NewRecommendationRouter(app, initializers.DB, jwtSecret, sessionService)
 
 	// Define routes for Auth
-	api.NewAuthRouter(app, initializers.DB, jwtSecret)
+	requireEmailVerification := os.Getenv("REQUIRE_EMAIL_VERIFICATION") == "true"
+	api.NewAuthRouter(app, initializers.DB, initializers.OAuthProviders, initializers.AdminOAuthProviders, initializers.DialerMail, initializers.VerifyEmailTemplate, initializers.ResetPasswordTemplate,
+		initializers.BaseCallbackVerifyEmailURL, initializers.BaseCallbackResetPasswordURL, requireEmailVerification, jwtSecret, sessionService)
+
+	// Define routes for linked login methods
+	api.NewUserIdentityRouter(app, initializers.DB, initializers.OAuthProviders, jwtSecret, sessionService)
+
+	// Define routes for profile experiences
+	api.NewExperienceRouter(app, initializers.DB, jwtSecret, sessionService)
 
 	// Define routes for Users
-	api.NewUserRouter(app, initializers.DB, initializers.S3, jwtSecret)
+	api.NewUserRouter(app, initializers.DB, initializers.Enforcer, initializers.S3, jwtSecret, sessionService)
+
+	// Define routes for live events and Notifications
+	hub := api.NewStreamRouter(app, jwtSecret, sessionService)
+	notifications := api.NewNotificationRouter(app, initializers.DB, jwtSecret, sessionService, hub)
 
 	// Define routes for Roles
-	api.NewRoleRouter(app, initializers.DB, initializers.Enforcer, initializers.DialerMail, jwtSecret, initializers.InviteBodyTemplate, initializers.BaseCallbackInviteURL)
+	api.NewRoleRouter(app, initializers.DB, initializers.Enforcer, initializers.DialerMail, jwtSecret, sessionService, initializers.InviteBodyTemplate, initializers.BaseCallbackInviteURL,
+		notifications)
 
 	// Define routes for Organizations && Organization Open Jobs
-	api.NewOrganizationAdminRouter(app, initializers.DB, initializers.Enforcer, initializers.ESClient, initializers.S3, jwtSecret)
-	api.NewOrganizationRouter(app, initializers.DB, initializers.Enforcer, initializers.ESClient, initializers.S3)
+	api.NewOrganizationAdminRouter(app, initializers.DB, initializers.Enforcer, initializers.ESClient, initializers.S3, jwtSecret, sessionService, notifications)
+	api.NewOrganizationRouter(app, initializers.DB, initializers.Enforcer, initializers.ESClient, initializers.S3, jwtSecret, sessionService)
+
+	// Define routes for Organization Reviews
+	api.NewOrganizationReviewRouter(app, initializers.DB, initializers.Enforcer, jwtSecret, sessionService, notifications)
+
+	// Define routes for the System Admin console
+	api.NewSystemAdminRouter(app, initializers.DB, initializers.Enforcer, jwtSecret, sessionService)
+
+	// Define routes for the Organization Audit Log
+	api.NewAuditLogRouter(app, initializers.DB, initializers.Enforcer, jwtSecret, sessionService)
+
+	// Define routes for Reports and Moderation
+	api.NewReportRouter(app, initializers.DB, initializers.Enforcer, initializers.ESClient, jwtSecret, sessionService, notifications)
 
 	// Define routes for Events
-	api.NewEventAdminRouter(app, initializers.DB, initializers.Enforcer, initializers.ESClient, initializers.S3, jwtSecret)
-	api.NewEventRouter(app, initializers.DB, initializers.Enforcer, initializers.ESClient, initializers.S3, jwtSecret)
+	api.NewEventAdminRouter(app, initializers.DB, initializers.Enforcer, initializers.ESClient, initializers.S3, jwtSecret, sessionService, notifications)
+	api.NewEventRouter(app, initializers.DB, initializers.Enforcer, initializers.ESClient, initializers.S3, jwtSecret, sessionService)
+
+	// Define routes for Tickets
+	ticketQRSecret := os.Getenv("TICKET_QR_SECRET")
+	if ticketQRSecret == "" {
+		log.Fatal("TICKET_QR_SECRET is not set")
+	}
+	if ticketQRSecret == jwtSecret {
+		log.Fatal("TICKET_QR_SECRET must differ from JWT_SECRET")
+	}
+	api.NewTicketRouter(app, initializers.DB, initializers.Enforcer, jwtSecret, sessionService, ticketQRSecret, hub)
+
+	// Define routes for Event Participants
+	api.NewEventParticipantRouter(app, initializers.DB, initializers.Enforcer, jwtSecret, sessionService)
+
+	// Define routes for Job Applications
+	api.NewApplicationRouter(app, initializers.DB, initializers.Enforcer, initializers.S3, jwtSecret, sessionService)
+
+	// Define routes for Bookmarks
+	api.NewBookmarkRouter(app, initializers.DB, jwtSecret, sessionService)
+
+	// Define routes for Follows
+	api.NewFollowRouter(app, initializers.DB, jwtSecret, sessionService)
+
+	// Define routes for Saved Searches
+	digestInterval := time.Hour
+	if interval, err := time.ParseDuration(os.Getenv("SAVED_SEARCH_DIGEST_INTERVAL")); err == nil && interval > 0 {
+		digestInterval = interval
+	}
+	savedSearchService := api.NewSavedSearchRouter(app, initializers.DB, initializers.ESClient, initializers.DialerMail, initializers.SavedSearchDigestTemplate,
+		initializers.BaseWebURL, initializers.BaseUnsubscribeDigestURL, jwtSecret, sessionService)
+	digestsDone := make(chan struct{})
+	go func() {
+		defer close(digestsDone)
+		scheduler.Every(ctx, digestInterval, "saved search digests", savedSearchService.SendDueDigests)
+	}()
 
 	// Define routes for Locations
 	api.NewLocationMapRouter(app, initializers.DB)
@@ -223,10 +296,22 @@ func Start() {
 	// fmt.Printf("Server is running on port %v\n", viper.GetInt("app.port"))
 
 	// logs.Info("Server is running on port: " + viper.GetString("app.port"))
+	go func() {
+		<-ctx.Done()
+		logs.Info("Shutting down")
+		if err := app.Shutdown(); err != nil {
+			logs.Error(fmt.Sprintf("Failed to shut the server down: %v", err))
+		}
+	}()
+
 	logs.Info(fmt.Sprintf("Server is running on port: %v", os.Getenv("APP_PORT")))
 	// err := app.Listen(fmt.Sprintf(":%v", viper.GetInt("app.port")))
 	err := app.Listen(fmt.Sprintf(":%v", os.Getenv("APP_PORT")))
 	if err != nil {
 		log.Fatal(err)
 	}
+
+	// Let a digest run in progress finish before exiting
+	stop()
+	<-digestsDone
 }
@@ -0,0 +1,105 @@
+package dto
+
+import "github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+
+type UpdateApplicationStatusRequest struct {
+	Status string `json:"status" example:"reviewing" validate:"required,oneof=reviewing interview offered rejected"`
+	Note   string `json:"note" example:"Strong portfolio, invite to interview" validate:"max=2000"`
+}
+
+// ApplicationStatusChangeResponse is a status change as seen by the applicant, without the
+// internal note of the reviewer
+type ApplicationStatusChangeResponse struct {
+	FromStatus string `json:"fromStatus" example:"submitted"`
+	ToStatus   string `json:"toStatus" example:"reviewing"`
+	ChangedAt  string `json:"changedAt" example:"2025-01-24 13:22:10"`
+}
+
+// ApplicationReviewChangeResponse is a status change as seen by the organization
+type ApplicationReviewChangeResponse struct {
+	ApplicationStatusChangeResponse
+	Note string `json:"note" example:"Strong portfolio, invite to interview"`
+}
+
+type ApplicationResponse struct {
+	ID               uint                              `json:"id" example:"1"`
+	JobID            uint                              `json:"jobId" example:"1"`
+	JobTitle         string                            `json:"jobTitle" example:"Software Engineer"`
+	OrganizationID   uint                              `json:"organizationId" example:"1"`
+	OrganizationName string                            `json:"organizationName" example:"DAF Bridge"`
+	CoverNote        string                            `json:"coverNote" example:"I would love to join the team because..."`
+	Status           string                            `json:"status" example:"submitted"`
+	SubmittedAt      string                            `json:"submittedAt" example:"2025-01-24 13:22:10"`
+	UpdatedAt        string                            `json:"updatedAt" example:"2025-01-24 13:22:10"`
+	StatusHistory    []ApplicationStatusChangeResponse `json:"statusHistory,omitempty"`
+}
+
+// ApplicantApplicationResponse is an application as seen by the organization reviewing it
+type ApplicantApplicationResponse struct {
+	ApplicationResponse
+	// StatusHistory replaces the one of ApplicationResponse with the notes of the reviewers
+	StatusHistory []ApplicationReviewChangeResponse `json:"statusHistory,omitempty"`
+	Applicant     UserResponses                     `json:"applicant"`
+}
+
+func BuildApplicationResponse(application models.Application) ApplicationResponse {
+	history := make([]ApplicationStatusChangeResponse, 0, len(application.StatusHistory))
+	for _, change := range application.StatusHistory {
+		history = append(history, buildApplicationStatusChangeResponse(change))
+	}
+
+	return ApplicationResponse{
+		ID:               application.ID,
+		JobID:            application.JobID,
+		JobTitle:         application.Job.Title,
+		OrganizationID:   application.Job.OrganizationID,
+		OrganizationName: application.Job.Organization.Name,
+		CoverNote:        application.CoverNote,
+		Status:           string(application.Status),
+		SubmittedAt:      application.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:        application.UpdatedAt.Format("2006-01-02 15:04:05"),
+		StatusHistory:    history,
+	}
+}
+
+func BuildApplicationResponses(applications []models.Application) []ApplicationResponse {
+	responses := make([]ApplicationResponse, 0, len(applications))
+	for _, application := range applications {
+		responses = append(responses, BuildApplicationResponse(application))
+	}
+
+	return responses
+}
+
+func buildApplicationStatusChangeResponse(change models.ApplicationStatusChange) ApplicationStatusChangeResponse {
+	return ApplicationStatusChangeResponse{
+		FromStatus: string(change.FromStatus),
+		ToStatus:   string(change.ToStatus),
+		ChangedAt:  change.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func BuildApplicantApplicationResponse(application models.Application) ApplicantApplicationResponse {
+	history := make([]ApplicationReviewChangeResponse, 0, len(application.StatusHistory))
+	for _, change := range application.StatusHistory {
+		history = append(history, ApplicationReviewChangeResponse{
+			ApplicationStatusChangeResponse: buildApplicationStatusChangeResponse(change),
+			Note:                            change.Note,
+		})
+	}
+
+	return ApplicantApplicationResponse{
+		ApplicationResponse: BuildApplicationResponse(application),
+		StatusHistory:       history,
+		Applicant:           BuildUserResponses(application.User),
+	}
+}
+
+func BuildApplicantApplicationResponses(applications []models.Application) []ApplicantApplicationResponse {
+	responses := make([]ApplicantApplicationResponse, 0, len(applications))
+	for _, application := range applications {
+		responses = append(responses, BuildApplicantApplicationResponse(application))
+	}
+
+	return responses
+}
@@ -0,0 +1,22 @@
+package dto
+
+import "encoding/json"
+
+type AuditLogResponse struct {
+	ID             uint   `json:"id" example:"1"`
+	ActorID        string `json:"actorId" example:"6f1c0b5e-8a0e-4a4b-9c57-2f1d1c3b9a10"`
+	OrganizationID uint   `json:"organizationId" example:"3"`
+	Resource       string `json:"resource" example:"Event"`
+	Action         string `json:"action" example:"update"`
+	Method         string `json:"method" example:"PUT"`
+	Path           string `json:"path" example:"/admin/orgs/3/events/42"`
+	// Changes maps each changed field to its value before and after, e.g. {"name": {"before": "Old", "after": "New"}}
+	Changes   json.RawMessage `json:"changes" swaggertype:"object"`
+	IP        string          `json:"ip" example:"203.0.113.7"`
+	CreatedAt string          `json:"createdAt" example:"2025-01-24T13:22:10Z"`
+}
+
+type PaginatedAuditLogResponse struct {
+	Logs      []AuditLogResponse `json:"logs"`
+	TotalLogs int64              `json:"total_logs" example:"1"`
+}
@@ -0,0 +1,17 @@
+package dto
+
+type BookmarkRequest struct {
+	TargetType string `json:"targetType" example:"event" validate:"required,oneof=event job organization"`
+	TargetID   uint   `json:"targetId" example:"1" validate:"required"`
+}
+
+// BookmarkResponse carries the bookmarked item in the field matching its type
+type BookmarkResponse struct {
+	ID           uint                       `json:"id" example:"1"`
+	TargetType   string                     `json:"targetType" example:"event"`
+	TargetID     uint                       `json:"targetId" example:"1"`
+	CreatedAt    string                     `json:"createdAt" example:"2025-01-24T13:22:10"`
+	Event        *EventDocumentDTOResponse  `json:"event,omitempty"`
+	Job          *JobDocumentDTOResponse    `json:"job,omitempty"`
+	Organization *OrganizationShortDocument `json:"organization,omitempty"`
+}
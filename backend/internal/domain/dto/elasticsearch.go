@@ -24,6 +24,16 @@ type SearchJobQuery struct {
 	SalaryUpperBound float64 `json:"salaryUpperBound" form:"salaryUpperBound"` // Salary upper bound
 }
 
+type SearchOrganizationQuery struct {
+	Page     int    `json:"page" form:"page"`         // The page number
+	Offset   int    `json:"offset" form:"offset"`     // The number of items per page
+	Q        string `json:"q" form:"q"`               // The search keyword
+	Industry string `json:"industry" form:"industry"` // Industry filter, comma separated (e.g., 'Technology,Education')
+	Province string `json:"province" form:"province"` // Province filter (e.g., 'Chiang Mai')
+	Country  string `json:"country" form:"country"`   // Country filter (e.g., 'Thailand')
+	Status   string `json:"status" form:"status"`     // Organization status (e.g., 'approved')
+}
+
 // Document for Elasticsearch/Opensearch
 
 type EventDocument struct {
@@ -74,20 +84,24 @@ type JobDocument struct {
 	Province      string                    `json:"province"`
 	Country       string                    `json:"country"`
 	UpdateAt      string                    `json:"updatedAt"`
+	IsBookmarked  *bool                     `json:"isBookmarked,omitempty"` // Not indexed, only set in search results for a logged-in caller
 }
 
 type OrganizationDocument struct {
-	ID          uint    `json:"id"`
-	Name        string  `json:"name"`
-	PicUrl      string  `json:"picUrl"`
-	Description string  `json:"description"`
-	Latitude    float64 `json:"latitude"`
-	Longitude   float64 `json:"longitude"`
-	Province    string  `json:"province"`
-	Country     string  `json:"country"`
-	Email       string  `json:"email"`
-	Phone       string  `json:"phone"`
-	UpdateAt    string  `json:"updatedAt"`
+	ID          uint     `json:"id"`
+	Name        string   `json:"name"`
+	PicUrl      string   `json:"picUrl"`
+	HeadLine    string   `json:"headline"`
+	Description string   `json:"description"`
+	Latitude    float64  `json:"latitude"`
+	Longitude   float64  `json:"longitude"`
+	Province    string   `json:"province"`
+	Country     string   `json:"country"`
+	Email       string   `json:"email"`
+	Phone       string   `json:"phone"`
+	Industries  []string `json:"industries"`
+	Status      string   `json:"status"`
+	UpdateAt    string   `json:"updatedAt"`
 }
 
 type SearchEventResponse struct {
@@ -124,6 +138,7 @@ type EventDocumentDTOResponse struct {
 	Audience     string                    `json:"audience"`
 	Price        string                    `json:"price"`
 	UpdateAt     string                    `json:"updatedAt"`
+	IsBookmarked *bool                     `json:"isBookmarked,omitempty"` // Only set for a logged-in caller
 }
 
 type JobDocumentDTOResponse struct {
@@ -141,4 +156,5 @@ type JobDocumentDTOResponse struct {
 	Province      string                    `json:"province"`
 	Country       string                    `json:"country"`
 	UpdateAt      string                    `json:"updatedAt"`
+	IsBookmarked  *bool                     `json:"isBookmarked,omitempty"` // Only set for a logged-in caller
 }
@@ -44,6 +44,7 @@ type NewEventRequest struct {
 	Audience        string                           `json:"audience" example:"general" validate:"required"`
 	PriceType       string                           `json:"priceType" example:"free" validate:"required"`
 	RegisterLink    string                           `json:"registerLink" example:"https://example.com/register" validate:"required"`
+	Capacity        int                              `json:"capacity" example:"100" validate:"min=0"`
 	Status          string                           `json:"status" example:"draft" validate:"required"`
 	Categories      []CategoryRequest                `json:"categories" validate:"required"`
 	ContactChannels []NewEventContactChannelsRequest `json:"contactChannels" validate:"required"`
@@ -73,6 +74,7 @@ type EventResponses struct {
 	Audience        string                          `json:"audience" example:"general"`
 	PriceType       string                          `json:"priceType" example:"free"`
 	RegisterLink    string                          `json:"registerLink" example:"https://example.com/register"`
+	Capacity        int                             `json:"capacity" example:"100"`
 	Status          string                          `json:"status" example:"published"`
 	Organization    OrganizationResponse            `json:"organization"`
 	Categories      []CategoryResponses             `json:"categories" example:"[{\"id\": 1, \"name\": \"all\"}]"`
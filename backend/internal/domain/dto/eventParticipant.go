@@ -0,0 +1,86 @@
+package dto
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type RegisterEventRequest struct {
+	IsVisible *bool `json:"isVisible" example:"true"`
+}
+
+type UpdateParticipantVisibilityRequest struct {
+	IsVisible *bool `json:"isVisible" example:"false" validate:"required"`
+}
+
+type EventRegistrationResponse struct {
+	EventID      uint   `json:"eventId" example:"1"`
+	IsVisible    bool   `json:"isVisible" example:"true"`
+	RegisteredAt string `json:"registeredAt" example:"2025-01-24 13:22:10"`
+}
+
+func BuildEventRegistrationResponse(participant models.EventParticipant) EventRegistrationResponse {
+	return EventRegistrationResponse{
+		EventID:      participant.EventId,
+		IsVisible:    participant.IsVisible,
+		RegisteredAt: participant.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+type PublicParticipantResponse struct {
+	ID     uuid.UUID `json:"id" example:"48a18dd9-48c3-45a5-b4f3-e8d7a60e2910"`
+	Name   string    `json:"name" example:"Anda Raiwin"`
+	PicUrl string    `json:"picUrl" example:"https://example.com/image.jpg"`
+}
+
+type PublicParticipantListResponse struct {
+	TotalParticipants int64                       `json:"total_participants" example:"1"`
+	Participants      []PublicParticipantResponse `json:"participants"`
+}
+
+func BuildPublicParticipantListResponse(participants []models.EventParticipant, total int64) PublicParticipantListResponse {
+	responses := make([]PublicParticipantResponse, 0, len(participants))
+	for _, participant := range participants {
+		responses = append(responses, PublicParticipantResponse{
+			ID:     participant.User.ID,
+			Name:   participant.User.Name,
+			PicUrl: participant.User.PicUrl,
+		})
+	}
+
+	return PublicParticipantListResponse{
+		TotalParticipants: total,
+		Participants:      responses,
+	}
+}
+
+type ParticipantResponse struct {
+	User         UserResponses `json:"user"`
+	IsVisible    bool          `json:"isVisible" example:"true"`
+	RegisteredAt string        `json:"registeredAt" example:"2025-01-24 13:22:10"`
+}
+
+func BuildParticipantResponse(participant models.EventParticipant) ParticipantResponse {
+	return ParticipantResponse{
+		User:         BuildUserResponses(participant.User),
+		IsVisible:    participant.IsVisible,
+		RegisteredAt: participant.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+type ParticipantListResponse struct {
+	TotalParticipants int                   `json:"total_participants" example:"1"`
+	Participants      []ParticipantResponse `json:"participants"`
+}
+
+func BuildParticipantListResponse(participants []models.EventParticipant) ParticipantListResponse {
+	responses := make([]ParticipantResponse, 0, len(participants))
+	for _, participant := range participants {
+		responses = append(responses, BuildParticipantResponse(participant))
+	}
+
+	return ParticipantListResponse{
+		TotalParticipants: len(responses),
+		Participants:      responses,
+	}
+}
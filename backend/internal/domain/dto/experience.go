@@ -0,0 +1,53 @@
+package dto
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type ExperienceRequest struct {
+	Title       string `json:"title" example:"Software Engineer Intern" validate:"required,max=255"`
+	Description string `json:"description" example:"Built the volunteer matching service"`
+	PicUrl      string `json:"picUrl" example:"https://example.com/company-logo.png" validate:"omitempty,max=255"`
+	StartDate   string `json:"startDate" example:"2024-06-01" validate:"required"`
+	EndDate     string `json:"endDate" example:"2024-08-31"`
+	Currently   bool   `json:"currently" example:"false"`
+}
+
+type ExperienceResponse struct {
+	ID          uuid.UUID `json:"id" example:"0f1c2b6e-5d0a-4f5e-9a55-2b3c1d4e5f60"`
+	Title       string    `json:"title" example:"Software Engineer Intern"`
+	Description string    `json:"description" example:"Built the volunteer matching service"`
+	PicUrl      string    `json:"picUrl" example:"https://example.com/company-logo.png"`
+	StartDate   string    `json:"startDate" example:"2024-06-01"`
+	EndDate     string    `json:"endDate" example:"2024-08-31"`
+	Currently   bool      `json:"currently" example:"false"`
+	UpdatedAt   string    `json:"updatedAt" example:"2025-01-24T13:22:10"`
+}
+
+func BuildExperienceResponse(experience models.Experience) ExperienceResponse {
+	endDate := ""
+	if experience.EndDate != nil {
+		endDate = experience.EndDate.Format("2006-01-02")
+	}
+
+	return ExperienceResponse{
+		ID:          experience.UUID,
+		Title:       experience.Title,
+		Description: experience.Description,
+		PicUrl:      experience.PicUrl,
+		StartDate:   experience.StartDate.Format("2006-01-02"),
+		EndDate:     endDate,
+		Currently:   experience.Currently,
+		UpdatedAt:   experience.UpdatedAt.Format("2006-01-02T15:04:05"),
+	}
+}
+
+func BuildExperienceResponses(experiences []models.Experience) []ExperienceResponse {
+	responses := make([]ExperienceResponse, 0, len(experiences))
+	for _, experience := range experiences {
+		responses = append(responses, BuildExperienceResponse(experience))
+	}
+
+	return responses
+}
@@ -0,0 +1,21 @@
+package dto
+
+type FollowResponse struct {
+	OrganizationID uint  `json:"organizationId" example:"1"`
+	Following      bool  `json:"following" example:"true"`
+	FollowerCount  int64 `json:"followerCount" example:"12"`
+}
+
+// FeedItemResponse carries the published item in the field matching its type
+type FeedItemResponse struct {
+	Type        string                    `json:"type" example:"event"`
+	PublishedAt string                    `json:"publishedAt" example:"2025-01-24T13:22:10Z"`
+	Event       *EventDocumentDTOResponse `json:"event,omitempty"`
+	Job         *JobDocumentDTOResponse   `json:"job,omitempty"`
+}
+
+type FeedResponse struct {
+	Items []FeedItemResponse `json:"items"`
+	// NextCursor is passed back as the cursor query to get the next page. It is empty on the last page.
+	NextCursor string `json:"nextCursor,omitempty" example:"MTczNzcyNDkzMDUzMjY0NXxldmVudHwxMg"`
+}
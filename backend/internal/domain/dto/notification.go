@@ -0,0 +1,22 @@
+package dto
+
+type NotificationResponse struct {
+	ID             uint    `json:"id" example:"1"`
+	Type           string  `json:"type" example:"role_changed"`
+	Title          string  `json:"title" example:"Your role in builds CMU changed"`
+	Body           string  `json:"body" example:"You are now an owner of builds CMU."`
+	OrganizationID *uint   `json:"organizationId,omitempty" example:"1"`
+	TargetID       *uint   `json:"targetId,omitempty" example:"12"`
+	Read           bool    `json:"read" example:"false"`
+	ReadAt         *string `json:"readAt,omitempty" example:"2025-01-24T13:22:10Z"`
+	CreatedAt      string  `json:"createdAt" example:"2025-01-24T13:22:10Z"`
+}
+
+type NotificationListResponse struct {
+	Notifications []NotificationResponse `json:"notifications"`
+	UnreadCount   int64                  `json:"unreadCount" example:"3"`
+}
+
+type UnreadCountResponse struct {
+	UnreadCount int64 `json:"unreadCount" example:"3"`
+}
@@ -245,6 +245,8 @@ type OrganizationResponse struct {
 	Longitude           float64                        `json:"longitude" example:"98.9937"`
 	OrganizationContact []OrganizationContactResponses `json:"organizationContacts"`
 	Industries          []IndustryResponses            `json:"industries"`
+	Status              string                         `json:"status" example:"approved"`
+	FollowerCount       int64                          `json:"followerCount" example:"12"`
 	UpdatedAt           string                         `json:"updatedAt" example:"2024-11-29 08:00:00"`
 }
 
@@ -267,6 +269,7 @@ func BuildOrganizationResponse(org models.Organization) OrganizationResponse {
 		Longitude:           org.Longitude,
 		OrganizationContact: BuildListOrganizationContactResponses(org.OrganizationContacts),
 		Industries:          BuildListIndustryResponses(org.Industries),
+		Status:              org.Status,
 		UpdatedAt:           org.UpdatedAt.Format("2006-01-02 15:04:05"),
 	}
 
@@ -0,0 +1,15 @@
+package dto
+
+type OrganizationReviewRequest struct {
+	// Reason is shown to the organization owners. It is required to reject or request changes.
+	Reason string `json:"reason" example:"Please add a contact email we can verify." validate:"max=2000"`
+}
+
+type OrganizationReviewResponse struct {
+	ID             uint   `json:"id" example:"1"`
+	OrganizationID uint   `json:"organizationId" example:"1"`
+	ReviewerID     string `json:"reviewerId" example:"6f1c0b5e-8a0e-4a4b-9c57-2f1d1c3b9a10"`
+	Decision       string `json:"decision" example:"changes_requested"`
+	Reason         string `json:"reason" example:"Please add a contact email we can verify."`
+	CreatedAt      string `json:"createdAt" example:"2025-01-24T13:22:10Z"`
+}
@@ -0,0 +1,48 @@
+package dto
+
+type ReportRequest struct {
+	TargetType string `json:"targetType" example:"event" validate:"required,oneof=event job organization user"`
+	// TargetID is the numeric ID of an event, job or organization, or the UUID of a user
+	TargetID string `json:"targetId" example:"42" validate:"required,max=64"`
+	Reason   string `json:"reason" example:"scam" validate:"required,oneof=spam scam harassment inappropriate misinformation other"`
+	Details  string `json:"details" example:"The job asks applicants to pay a registration fee." validate:"max=2000"`
+}
+
+type ReportResponse struct {
+	ID         uint                        `json:"id" example:"1"`
+	ReporterID string                      `json:"reporterId" example:"6f1c0b5e-8a0e-4a4b-9c57-2f1d1c3b9a10"`
+	TargetType string                      `json:"targetType" example:"event"`
+	TargetID   string                      `json:"targetId" example:"42"`
+	Reason     string                      `json:"reason" example:"scam"`
+	Details    string                      `json:"details" example:"The job asks applicants to pay a registration fee."`
+	Status     string                      `json:"status" example:"open"`
+	Decision   *ModerationDecisionResponse `json:"decision,omitempty"`
+	CreatedAt  string                      `json:"createdAt" example:"2025-01-24T13:22:10Z"`
+}
+
+type PaginatedReportsResponse struct {
+	Reports      []ReportResponse `json:"reports"`
+	TotalReports int64            `json:"total_reports" example:"1"`
+}
+
+// ReportDetailResponse is a report together with the moderation history of its target
+type ReportDetailResponse struct {
+	ReportResponse
+	History []ModerationDecisionResponse `json:"history"`
+}
+
+type ModerationRequest struct {
+	// Note is kept in the audit trail and shown to the organization when content is taken down
+	Note string `json:"note" example:"Asks applicants for a fee, which breaks the job posting rules." validate:"max=2000"`
+}
+
+type ModerationDecisionResponse struct {
+	ID             uint   `json:"id" example:"1"`
+	ModeratorID    string `json:"moderatorId" example:"6f1c0b5e-8a0e-4a4b-9c57-2f1d1c3b9a10"`
+	Action         string `json:"action" example:"hide"`
+	TargetType     string `json:"targetType" example:"job"`
+	TargetID       string `json:"targetId" example:"42"`
+	OrganizationID *uint  `json:"organizationId" example:"3"`
+	Note           string `json:"note" example:"Asks applicants for a fee, which breaks the job posting rules."`
+	CreatedAt      string `json:"createdAt" example:"2025-01-24T13:22:10Z"`
+}
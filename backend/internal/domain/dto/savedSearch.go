@@ -0,0 +1,67 @@
+package dto
+
+import (
+	"encoding/json"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+)
+
+// SavedSearchRequest saves a search. Query takes the same fields as the job search
+// (SearchJobQuery) or the event search (SearchQuery), depending on Kind.
+type SavedSearchRequest struct {
+	Name      string          `json:"name" example:"Remote Go jobs" validate:"required,max=255"`
+	Kind      string          `json:"kind" example:"job" validate:"required,oneof=job event"`
+	Query     json.RawMessage `json:"query" swaggertype:"object" validate:"required"`
+	Frequency string          `json:"frequency" example:"weekly" validate:"required,oneof=daily weekly"`
+}
+
+type UpdateSavedSearchRequest struct {
+	Name       string          `json:"name" example:"Remote Go jobs" validate:"required,max=255"`
+	Query      json.RawMessage `json:"query" swaggertype:"object" validate:"required"`
+	Frequency  string          `json:"frequency" example:"weekly" validate:"required,oneof=daily weekly"`
+	Subscribed bool            `json:"subscribed" example:"true"`
+}
+
+type UnsubscribeSavedSearchRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+type SavedSearchResponse struct {
+	ID         uint            `json:"id" example:"1"`
+	Name       string          `json:"name" example:"Remote Go jobs"`
+	Kind       string          `json:"kind" example:"job"`
+	Query      json.RawMessage `json:"query" swaggertype:"object"`
+	Frequency  string          `json:"frequency" example:"weekly"`
+	Subscribed bool            `json:"subscribed" example:"true"`
+	LastRunAt  string          `json:"lastRunAt" example:"2025-01-24T13:22:10"`
+	NextRunAt  string          `json:"nextRunAt" example:"2025-01-31T13:22:10"`
+	CreatedAt  string          `json:"createdAt" example:"2025-01-24T13:22:10"`
+}
+
+func BuildSavedSearchResponse(savedSearch models.SavedSearch) SavedSearchResponse {
+	lastRunAt := ""
+	if savedSearch.LastRunAt != nil {
+		lastRunAt = savedSearch.LastRunAt.Format("2006-01-02T15:04:05")
+	}
+
+	return SavedSearchResponse{
+		ID:         savedSearch.ID,
+		Name:       savedSearch.Name,
+		Kind:       string(savedSearch.Kind),
+		Query:      json.RawMessage(savedSearch.Query),
+		Frequency:  string(savedSearch.Frequency),
+		Subscribed: savedSearch.Subscribed,
+		LastRunAt:  lastRunAt,
+		NextRunAt:  savedSearch.NextRunAt.Format("2006-01-02T15:04:05"),
+		CreatedAt:  savedSearch.CreatedAt.Format("2006-01-02T15:04:05"),
+	}
+}
+
+func BuildSavedSearchResponses(savedSearches []models.SavedSearch) []SavedSearchResponse {
+	responses := make([]SavedSearchResponse, 0, len(savedSearches))
+	for _, savedSearch := range savedSearches {
+		responses = append(responses, BuildSavedSearchResponse(savedSearch))
+	}
+
+	return responses
+}
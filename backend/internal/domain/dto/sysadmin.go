@@ -0,0 +1,55 @@
+package dto
+
+type SysAdminUserResponse struct {
+	ID              string  `json:"id" example:"48a18dd9-48c3-45a5-b4f3-e8d7a60e2910"`
+	Name            string  `json:"name" example:"Anda Raiwin"`
+	Email           string  `json:"email" example:"andaraiwin@gmail.com"`
+	PicUrl          string  `json:"picUrl" example:"https://anda-daf-bridge.s3.amazonaws.com/users/profile-pic/48a18dd9-48c3-45a5-b4f3-e8d7a60e2910.png"`
+	Role            string  `json:"role" example:"User"`
+	IsSystemAdmin   bool    `json:"isSystemAdmin" example:"false"`
+	EmailVerified   bool    `json:"emailVerified" example:"true"`
+	SuspendedAt     *string `json:"suspendedAt" example:"2025-01-24T13:22:10Z"`
+	SuspendedReason string  `json:"suspendedReason" example:"Spam"`
+	CreatedAt       string  `json:"createdAt" example:"2025-01-24T13:22:10Z"`
+}
+
+type PaginatedSysAdminUsersResponse struct {
+	Users      []SysAdminUserResponse `json:"users"`
+	TotalUsers int64                  `json:"total_users" example:"1"`
+}
+
+type SuspendUserRequest struct {
+	Reason string `json:"reason" example:"Posting scam job offers" validate:"required,max=2000"`
+}
+
+type SysAdminCategoryRequest struct {
+	Name      string `json:"name" example:"workshop" validate:"required,max=255"`
+	Slug      string `json:"slug" example:"workshop" validate:"required,max=255"`
+	ParentID  *uint  `json:"parentId" example:"1"`
+	IsActive  *bool  `json:"isActive" example:"true"` // Defaults to true
+	SortOrder int    `json:"sortOrder" example:"1"`
+}
+
+type SysAdminCategoryResponse struct {
+	ID        uint   `json:"id" example:"1"`
+	Name      string `json:"name" example:"workshop"`
+	Slug      string `json:"slug" example:"workshop"`
+	ParentID  *uint  `json:"parentId" example:"1"`
+	IsActive  bool   `json:"isActive" example:"true"`
+	SortOrder int    `json:"sortOrder" example:"1"`
+}
+
+type IndustryRequest struct {
+	Name string `json:"name" example:"Software" validate:"required,max=255"`
+}
+
+type PlatformStatsResponse struct {
+	Users                 int64            `json:"users" example:"1200"`
+	SuspendedUsers        int64            `json:"suspendedUsers" example:"3"`
+	NewUsersLast30Days    int64            `json:"newUsersLast30Days" example:"85"`
+	OrganizationsByStatus map[string]int64 `json:"organizationsByStatus"`
+	EventsByStatus        map[string]int64 `json:"eventsByStatus"`
+	JobsByStatus          map[string]int64 `json:"jobsByStatus"`
+	Applications          int64            `json:"applications" example:"430"`
+	TicketsPurchased      int64            `json:"ticketsPurchased" example:"2100"`
+}
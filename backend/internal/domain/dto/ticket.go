@@ -0,0 +1,104 @@
+package dto
+
+import "github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+
+type TicketAvailableRequest struct {
+	Title       string  `json:"title" example:"Early Bird" validate:"required"`
+	Description string  `json:"description" example:"Discounted ticket for the first 50 buyers"`
+	Quantity    int     `json:"quantity" example:"50" validate:"min=0"`
+	Price       float64 `json:"price" example:"500" validate:"gt=0"`
+}
+
+type TicketAvailableResponse struct {
+	ID          uint    `json:"id" example:"1"`
+	EventID     uint    `json:"eventId" example:"1"`
+	Title       string  `json:"title" example:"Early Bird"`
+	Description string  `json:"description" example:"Discounted ticket for the first 50 buyers"`
+	Quantity    int     `json:"quantity" example:"50"`
+	Price       float64 `json:"price" example:"500"`
+	SoldOut     bool    `json:"soldOut" example:"false"`
+}
+
+func BuildTicketAvailableResponse(ticket models.TicketAvailable) TicketAvailableResponse {
+	return TicketAvailableResponse{
+		ID:          ticket.ID,
+		EventID:     ticket.EventID,
+		Title:       ticket.Title,
+		Description: ticket.Description,
+		Quantity:    ticket.Quantity,
+		Price:       ticket.Price,
+		SoldOut:     ticket.Quantity == 0,
+	}
+}
+
+func BuildListTicketAvailableResponse(tickets []models.TicketAvailable) []TicketAvailableResponse {
+	responses := make([]TicketAvailableResponse, 0, len(tickets))
+	for _, ticket := range tickets {
+		responses = append(responses, BuildTicketAvailableResponse(ticket))
+	}
+	return responses
+}
+
+type PurchaseTicketRequest struct {
+	Phone string `json:"phone" example:"0812345678" validate:"omitempty,max=20"`
+}
+
+type TicketPurchasedResponse struct {
+	ID             uint                  `json:"id" example:"1"`
+	TicketID       uint                  `json:"ticketId" example:"1"`
+	TicketTitle    string                `json:"ticketTitle" example:"Early Bird"`
+	Username       string                `json:"username" example:"John Doe"`
+	Email          string                `json:"email" example:"john@example.com"`
+	Phone          string                `json:"phone" example:"0812345678"`
+	Qrcode         string                `json:"qrcode" example:"1f0e4c1a-7b0d-4d6b-8d43-5b1b8c7e9f10"`
+	ConfirmationAt string                `json:"confirmationAt" example:""`
+	Event          EventShortResponseDTO `json:"event"`
+	PurchasedAt    string                `json:"purchasedAt" example:"2025-01-24 13:22:10"`
+}
+
+func BuildTicketPurchasedResponse(purchase models.TicketPurchased) TicketPurchasedResponse {
+	return TicketPurchasedResponse{
+		ID:             purchase.ID,
+		TicketID:       purchase.TicketAvailableID,
+		TicketTitle:    purchase.TicketTitle,
+		Username:       purchase.Username,
+		Email:          purchase.Email,
+		Phone:          purchase.Phone,
+		Qrcode:         purchase.Qrcode,
+		ConfirmationAt: purchase.ConfirmationAt,
+		Event: EventShortResponseDTO{
+			ID:        int(purchase.Event.ID),
+			Name:      purchase.Event.Name,
+			StartDate: purchase.Event.StartDate.Format("2006-01-02"),
+			EndDate:   purchase.Event.EndDate.Format("2006-01-02"),
+			StartTime: purchase.Event.StartTime.Format("15:04:05"),
+			EndTime:   purchase.Event.EndTime.Format("15:04:05"),
+			PicUrl:    purchase.Event.PicUrl,
+			Location:  purchase.Event.LocationName,
+		},
+		PurchasedAt: purchase.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func BuildListTicketPurchasedResponse(purchases []models.TicketPurchased) []TicketPurchasedResponse {
+	responses := make([]TicketPurchasedResponse, 0, len(purchases))
+	for _, purchase := range purchases {
+		responses = append(responses, BuildTicketPurchasedResponse(purchase))
+	}
+	return responses
+}
+
+type CheckInRequest struct {
+	Qrcode string `json:"qrcode" example:"t1.1.Zk9mY2F0c2RvZ3M.hX2k..." validate:"required"`
+}
+
+type CheckInResponse struct {
+	Ticket     TicketPurchasedResponse `json:"ticket"`
+	Attendance AttendanceResponse      `json:"attendance"`
+}
+
+type AttendanceResponse struct {
+	EventID   uint  `json:"eventId" example:"1"`
+	Total     int64 `json:"total" example:"120"`
+	CheckedIn int64 `json:"checkedIn" example:"87"`
+}
@@ -26,15 +26,16 @@ func BuildUserResponses(user models.User) UserResponses {
 }
 
 type ProfileResponses struct {
-	ID        uuid.UUID `json:"id" example:"48a18dd9-48c3-45a5-b4f3-e8d7a60e2910"`
-	FirstName string    `json:"firstName" example:"Anda"`
-	LastName  string    `json:"lastName" example:"Raiwin"`
-	Email     string    `json:"email" example:"andaraiwin@gmail.com"`
-	Phone     string    `json:"phone" example:"08123456789"`
-	PicUrl    string    `json:"picUrl" example:"https://anda-daf-bridge.s3.amazonaws.com/users/profile-pic/48a18dd9-48c3-45a5-b4f3-e8d7a60e2910.png"`
-	Language  string    `json:"language" example:"Indonesia"`
-	Role      string    `json:"role" example:"User"`
-	UpdateAt  string    `json:"updatedAt" example:"2025-01-24T13:22:10.532645Z"`
+	ID          uuid.UUID            `json:"id" example:"48a18dd9-48c3-45a5-b4f3-e8d7a60e2910"`
+	FirstName   string               `json:"firstName" example:"Anda"`
+	LastName    string               `json:"lastName" example:"Raiwin"`
+	Email       string               `json:"email" example:"andaraiwin@gmail.com"`
+	Phone       string               `json:"phone" example:"08123456789"`
+	PicUrl      string               `json:"picUrl" example:"https://anda-daf-bridge.s3.amazonaws.com/users/profile-pic/48a18dd9-48c3-45a5-b4f3-e8d7a60e2910.png"`
+	Language    string               `json:"language" example:"Indonesia"`
+	Role        string               `json:"role" example:"User"`
+	UpdateAt    string               `json:"updatedAt" example:"2025-01-24T13:22:10.532645Z"`
+	Experiences []ExperienceResponse `json:"experiences"`
 }
 
 type SignUpRequest struct {
@@ -50,6 +51,31 @@ type LoginRequest struct {
 	Password string `json:"password" example:"$2a$10$GEMNCwJCpl2yRm.UirLrUuIG55oc8oLCcP4HRe0uPlTizoIVRAS6K" validate:"required"`
 }
 
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" example:"bXlSZWZyZXNoVG9rZW4"`
+}
+
+type AuthTokensResponse struct {
+	Message      string `json:"message" example:"Login successful"`
+	Token        string `json:"token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	TokenType    string `json:"token_type" example:"Bearer"`
+	ExpiresIn    int64  `json:"expires_in" example:"900"`
+	RefreshToken string `json:"refresh_token" example:"bXlSZWZyZXNoVG9rZW4"`
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token" example:"bXlWZXJpZnlUb2tlbg" validate:"required"`
+}
+
+type EmailRequest struct {
+	Email string `json:"email" example:"andaraiwin@gmail.com" validate:"required,email"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token" example:"bXlSZXNldFRva2Vu" validate:"required"`
+	Password string `json:"password" example:"n3w-Passw0rd" validate:"required,min=8"`
+}
+
 type UpdateProfileRequest struct {
 	FirstName string `json:"firstName" example:"Anda" validate:"required"`
 	LastName  string `json:"lastName" example:"Raiwin" validate:"required"`
@@ -149,3 +175,84 @@ type EventsAreInteractedByUserResponse struct {
 	UserResponses  UserResponses             `json:"user"`
 	EventResponses []EventWithCountResponses `json:"events"`
 }
+
+type ProfileVisibilityRequest struct {
+	ShowHeadline    bool `json:"showHeadline" example:"true"`
+	ShowBio         bool `json:"showBio" example:"true"`
+	ShowSkills      bool `json:"showSkills" example:"true"`
+	ShowExperiences bool `json:"showExperiences" example:"true"`
+	ShowEvents      bool `json:"showEvents" example:"false"`
+}
+
+type ProfileVisibilityResponse struct {
+	ShowHeadline    bool `json:"showHeadline" example:"true"`
+	ShowBio         bool `json:"showBio" example:"true"`
+	ShowSkills      bool `json:"showSkills" example:"true"`
+	ShowExperiences bool `json:"showExperiences" example:"true"`
+	ShowEvents      bool `json:"showEvents" example:"false"`
+}
+
+func BuildProfileVisibilityResponse(visibility models.ProfileVisibility) ProfileVisibilityResponse {
+	return ProfileVisibilityResponse{
+		ShowHeadline:    visibility.ShowHeadline,
+		ShowBio:         visibility.ShowBio,
+		ShowSkills:      visibility.ShowSkills,
+		ShowExperiences: visibility.ShowExperiences,
+		ShowEvents:      visibility.ShowEvents,
+	}
+}
+
+type PublicEventParticipationResponse struct {
+	EventID          uint   `json:"eventId" example:"1"`
+	Name             string `json:"name" example:"Builds Renewable Energy Summit"`
+	PicUrl           string `json:"picUrl" example:"https://example.com/image.jpg"`
+	StartDate        string `json:"startDate" example:"2025-01-15"`
+	OrganizationName string `json:"organizationName" example:"DAF Bridge"`
+}
+
+// PublicProfileResponse leaves out every section the owner has hidden
+type PublicProfileResponse struct {
+	ID          uuid.UUID                          `json:"id" example:"48a18dd9-48c3-45a5-b4f3-e8d7a60e2910"`
+	Name        string                             `json:"name" example:"Anda Raiwin"`
+	PicUrl      string                             `json:"picUrl" example:"https://anda-daf-bridge.s3.amazonaws.com/users/profile-pic/48a18dd9-48c3-45a5-b4f3-e8d7a60e2910.png"`
+	HeadLine    string                             `json:"headline,omitempty" example:"Data engineer for social impact"`
+	Bio         string                             `json:"bio,omitempty" example:"I build data pipelines for NGOs."`
+	Skills      string                             `json:"skills,omitempty" example:"Go, SQL, Data Visualization"`
+	Experiences []ExperienceResponse               `json:"experiences,omitempty"`
+	Events      []PublicEventParticipationResponse `json:"events,omitempty"`
+}
+
+func BuildPublicProfileResponse(profile models.Profile, visibility models.ProfileVisibility, participations []models.EventParticipant) PublicProfileResponse {
+	response := PublicProfileResponse{
+		ID:     profile.User.ID,
+		Name:   profile.User.Name,
+		PicUrl: profile.User.PicUrl,
+	}
+
+	if visibility.ShowHeadline {
+		response.HeadLine = profile.HeadLine
+	}
+	if visibility.ShowBio {
+		response.Bio = profile.Bio
+	}
+	if visibility.ShowSkills {
+		response.Skills = profile.Skill
+	}
+	if visibility.ShowExperiences {
+		response.Experiences = BuildExperienceResponses(profile.Experiences)
+	}
+	if visibility.ShowEvents {
+		response.Events = make([]PublicEventParticipationResponse, 0, len(participations))
+		for _, participation := range participations {
+			response.Events = append(response.Events, PublicEventParticipationResponse{
+				EventID:          participation.Event.ID,
+				Name:             participation.Event.Name,
+				PicUrl:           participation.Event.PicUrl,
+				StartDate:        participation.Event.StartDate.Format("2006-01-02"),
+				OrganizationName: participation.Event.Organization.Name,
+			})
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,42 @@
+package dto
+
+import "github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+
+type LinkPasswordRequest struct {
+	Password string `json:"password" example:"n3w-Passw0rd" validate:"required,min=8"`
+}
+
+type LinkProviderRequest struct {
+	Code string `json:"code" example:"4/0AQlEd8x..." validate:"required"`
+}
+
+type UserIdentityResponse struct {
+	Provider string `json:"provider" example:"google"`
+	Email    string `json:"email" example:"andaraiwin@gmail.com"`
+	LinkedAt string `json:"linkedAt" example:"2025-01-24 13:22:10"`
+}
+
+type LoginMethodsResponse struct {
+	HasPassword bool                   `json:"hasPassword" example:"true"`
+	Identities  []UserIdentityResponse `json:"identities"`
+}
+
+func BuildUserIdentityResponse(identity models.UserIdentity) UserIdentityResponse {
+	return UserIdentityResponse{
+		Provider: string(identity.Provider),
+		Email:    identity.Email,
+		LinkedAt: identity.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func BuildLoginMethodsResponse(hasPassword bool, identities []models.UserIdentity) LoginMethodsResponse {
+	responses := make([]UserIdentityResponse, 0, len(identities))
+	for _, identity := range identities {
+		responses = append(responses, BuildUserIdentityResponse(identity))
+	}
+
+	return LoginMethodsResponse{
+		HasPassword: hasPassword,
+		Identities:  responses,
+	}
+}
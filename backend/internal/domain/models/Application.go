@@ -0,0 +1,91 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"mime/multipart"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ApplicationStatus string
+
+const (
+	ApplicationStatusSubmitted ApplicationStatus = "submitted"
+	ApplicationStatusReviewing ApplicationStatus = "reviewing"
+	ApplicationStatusInterview ApplicationStatus = "interview"
+	ApplicationStatusOffered   ApplicationStatus = "offered"
+	ApplicationStatusRejected  ApplicationStatus = "rejected"
+	ApplicationStatusWithdrawn ApplicationStatus = "withdrawn"
+)
+
+// ErrApplicationStatusChanged is returned when an application left the expected status before it could be moved
+var ErrApplicationStatusChanged = errors.New("application status changed")
+
+// applicationTransitions is the hiring pipeline. Offered, rejected and withdrawn are final.
+var applicationTransitions = map[ApplicationStatus][]ApplicationStatus{
+	ApplicationStatusSubmitted: {ApplicationStatusReviewing, ApplicationStatusRejected, ApplicationStatusWithdrawn},
+	ApplicationStatusReviewing: {ApplicationStatusInterview, ApplicationStatusRejected, ApplicationStatusWithdrawn},
+	ApplicationStatusInterview: {ApplicationStatusOffered, ApplicationStatusRejected, ApplicationStatusWithdrawn},
+}
+
+// CanTransitionTo reports whether the pipeline allows moving from s to next
+func (s ApplicationStatus) CanTransitionTo(next ApplicationStatus) bool {
+	for _, allowed := range applicationTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// Application is a user's application to an OrgOpenJob. The resume is a private S3 object.
+type Application struct {
+	gorm.Model
+	JobID         uint                      `gorm:"not null;uniqueIndex:idx_application_job_user" json:"jobId"`
+	Job           OrgOpenJob                `gorm:"foreignKey:JobID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"job"`
+	UserID        uuid.UUID                 `gorm:"type:uuid;not null;uniqueIndex:idx_application_job_user;index" json:"userId"`
+	User          User                      `gorm:"foreignKey:UserID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"user"`
+	CoverNote     string                    `gorm:"type:text" json:"coverNote"`
+	ResumeKey     string                    `gorm:"type:varchar(255);not null" json:"-"`
+	Status        ApplicationStatus         `gorm:"type:varchar(32);not null;default:'submitted';index" json:"status"`
+	StatusHistory []ApplicationStatusChange `gorm:"foreignKey:ApplicationID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"statusHistory"`
+}
+
+// ApplicationStatusChange records one step of an application through the pipeline
+type ApplicationStatusChange struct {
+	gorm.Model
+	ApplicationID uint              `gorm:"not null;index" json:"applicationId"`
+	FromStatus    ApplicationStatus `gorm:"type:varchar(32);not null" json:"fromStatus"`
+	ToStatus      ApplicationStatus `gorm:"type:varchar(32);not null" json:"toStatus"`
+	ChangedByID   uuid.UUID         `gorm:"type:uuid;not null" json:"changedById"`
+	Note          string            `gorm:"type:text" json:"note"`
+}
+
+//---------------------------------------------------------------------------
+// Interfaces
+//---------------------------------------------------------------------------
+
+type ApplicationRepository interface {
+	Create(application *Application) error
+	GetByID(id uint) (*Application, error)
+	GetByIDAndJobID(jobID uint, id uint) (*Application, error)
+	ListByUserID(userID uuid.UUID) ([]Application, error)
+	ListByJobID(jobID uint, status ApplicationStatus) ([]Application, error)
+	// UpdateStatus moves the application only while it is still in change.FromStatus and records
+	// the change, returning ErrApplicationStatusChanged when another update got there first.
+	UpdateStatus(change *ApplicationStatusChange) error
+}
+
+type ApplicationService interface {
+	Apply(ctx context.Context, userID uuid.UUID, jobID uint, coverNote string, resume multipart.File, resumeHeader *multipart.FileHeader) (*Application, error)
+	ListMyApplications(userID uuid.UUID) ([]Application, error)
+	GetMyApplication(userID uuid.UUID, id uint) (*Application, error)
+	Withdraw(userID uuid.UUID, id uint) (*Application, error)
+	ListJobApplications(orgID uint, jobID uint, status ApplicationStatus) ([]Application, error)
+	GetJobApplication(orgID uint, jobID uint, id uint) (*Application, error)
+	UpdateStatus(orgID uint, jobID uint, id uint, changedBy uuid.UUID, status ApplicationStatus, note string) (*Application, error)
+	// ResumeURL returns a short-lived download link for the application's resume
+	ResumeURL(ctx context.Context, application *Application) (string, error)
+}
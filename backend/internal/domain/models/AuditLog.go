@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditLog records one change made through the organization admin API. Rows are only ever
+// inserted, the migration installs a trigger rejecting updates and deletes. There is no foreign
+// key on the actor or the organization so the trail outlives both.
+type AuditLog struct {
+	ID             uint      `gorm:"primaryKey" json:"id"`
+	ActorID        uuid.UUID `gorm:"type:uuid;not null;index" json:"actorId"`
+	OrganizationID uint      `gorm:"not null;index:idx_audit_log_org_created" json:"organizationId"`
+	Resource       string    `gorm:"type:varchar(64);not null" json:"resource"`
+	Action         string    `gorm:"type:varchar(32);not null" json:"action"`
+	Method         string    `gorm:"type:varchar(8);not null" json:"method"`
+	Path           string    `gorm:"type:varchar(255);not null" json:"path"`
+	// Changes holds the fields that changed as JSON, {"field": {"before": ..., "after": ...}}
+	Changes   string    `gorm:"type:jsonb;not null" json:"changes"`
+	IP        string    `gorm:"type:varchar(64)" json:"ip"`
+	CreatedAt time.Time `gorm:"index:idx_audit_log_org_created" json:"createdAt"`
+}
+
+// AuditLogFilter narrows down an audit log listing. Zero values match everything.
+type AuditLogFilter struct {
+	ActorID  *uuid.UUID
+	Resource string
+	Action   string
+	From     *time.Time
+	To       *time.Time
+}
+
+//---------------------------------------------------------------------------
+// Interfaces
+//---------------------------------------------------------------------------
+
+type AuditLogRepository interface {
+	Create(log *AuditLog) error
+	// ListByOrganization returns a page of the audit log of an organization, newest first
+	ListByOrganization(orgID uint, filter AuditLogFilter, page int, size int) ([]AuditLog, int64, error)
+}
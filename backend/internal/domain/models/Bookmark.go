@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type BookmarkTargetType string
+
+const (
+	BookmarkTargetEvent        BookmarkTargetType = "event"
+	BookmarkTargetJob          BookmarkTargetType = "job"
+	BookmarkTargetOrganization BookmarkTargetType = "organization"
+)
+
+// Bookmark is an event, job or organization a user saved for later. TargetID refers to the
+// table TargetType names, so it has no foreign key.
+type Bookmark struct {
+	ID         uint               `gorm:"primaryKey" json:"id"`
+	UserID     uuid.UUID          `gorm:"type:uuid;not null;uniqueIndex:idx_bookmark_user_target" json:"userId"`
+	User       User               `gorm:"foreignKey:UserID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	TargetType BookmarkTargetType `gorm:"type:varchar(16);not null;uniqueIndex:idx_bookmark_user_target" json:"targetType"`
+	TargetID   uint               `gorm:"not null;uniqueIndex:idx_bookmark_user_target" json:"targetId"`
+	CreatedAt  time.Time          `json:"createdAt"`
+}
+
+//---------------------------------------------------------------------------
+// Interfaces
+//---------------------------------------------------------------------------
+
+type BookmarkRepository interface {
+	Create(bookmark *Bookmark) error
+	Delete(userID uuid.UUID, targetType BookmarkTargetType, targetID uint) error
+	// ListByUserID returns the bookmarks of the user, newest first. An empty targetType lists every type.
+	ListByUserID(userID uuid.UUID, targetType BookmarkTargetType) ([]Bookmark, error)
+	// FilterBookmarked returns the subset of targetIDs the user has bookmarked
+	FilterBookmarked(userID uuid.UUID, targetType BookmarkTargetType, targetIDs []uint) ([]uint, error)
+	// TargetExists and the getters only see targets that are publicly visible
+	TargetExists(targetType BookmarkTargetType, targetID uint) (bool, error)
+	GetEventsByIDs(ids []uint) ([]Event, error)
+	GetJobsByIDs(ids []uint) ([]OrgOpenJob, error)
+	GetOrganizationsByIDs(ids []uint) ([]Organization, error)
+}
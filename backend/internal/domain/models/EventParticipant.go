@@ -1,15 +1,48 @@
 package models
 
 import (
+	"errors"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
+// ErrEventFull is returned when an event has reached its capacity
+var ErrEventFull = errors.New("event is full")
+
 type EventParticipant struct {
 	gorm.Model
-	UserId    uuid.UUID `gorm:"type:uuid;not null" json:"userId"`
+	UserId    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_event_participant_user_event" json:"userId"`
 	User      User      `gorm:"foreignKey:UserId;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"user"`
-	EventId   uint      `gorm:"type:uint;not null" json:"eventId"`
+	EventId   uint      `gorm:"type:uint;not null;uniqueIndex:idx_event_participant_user_event;index" json:"eventId"`
 	Event     Event     `gorm:"foreignKey:EventId;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"event"`
 	IsVisible bool      `gorm:"type:boolean" json:"isVisible"`
 }
+
+//---------------------------------------------------------------------------
+// Interfaces
+//---------------------------------------------------------------------------
+
+type EventParticipantRepository interface {
+	// Register locks the event row and stores the participant only while the event
+	// is below its capacity, returning ErrEventFull otherwise.
+	Register(participant *EventParticipant) error
+	Unregister(userID uuid.UUID, eventID uint) error
+	UpdateVisibility(userID uuid.UUID, eventID uint, isVisible bool) error
+	GetByUserIDAndEventID(userID uuid.UUID, eventID uint) (*EventParticipant, error)
+	GetAllByEventID(eventID uint) ([]EventParticipant, error)
+	GetVisibleByEventID(eventID uint) ([]EventParticipant, error)
+	// GetVisibleByUserID returns the registrations the user chose to show, with their events. Events
+	// hidden by moderation or of a suspended organization are left out.
+	GetVisibleByUserID(userID uuid.UUID) ([]EventParticipant, error)
+	CountByEventID(eventID uint) (int64, error)
+}
+
+type EventParticipantService interface {
+	Register(userID uuid.UUID, eventID uint, isVisible bool) (*EventParticipant, error)
+	Unregister(userID uuid.UUID, eventID uint) error
+	UpdateVisibility(userID uuid.UUID, eventID uint, isVisible bool) error
+	GetMyRegistration(userID uuid.UUID, eventID uint) (*EventParticipant, error)
+	ListPublicParticipants(eventID uint) ([]EventParticipant, int64, error)
+	ListParticipants(orgID uint, eventID uint) ([]EventParticipant, error)
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotificationType string
+
+const (
+	NotificationInvitation       NotificationType = "invitation"
+	NotificationRoleChanged      NotificationType = "role_changed"
+	NotificationOrgStatusChanged NotificationType = "organization_status_changed"
+	NotificationFollowedOrgEvent NotificationType = "followed_organization_event"
+	NotificationOrgReviewed      NotificationType = "organization_reviewed"
+	NotificationContentModerated NotificationType = "content_moderated"
+)
+
+// NotificationMessage is what a notification says, independent of who receives it
+type NotificationMessage struct {
+	Type           NotificationType
+	Title          string
+	Body           string
+	OrganizationID *uint
+	// TargetID refers to the item the notification is about, such as the new event
+	TargetID *uint
+}
+
+type Notification struct {
+	ID             uint             `gorm:"primaryKey" json:"id"`
+	UserID         uuid.UUID        `gorm:"type:uuid;not null;index:idx_notification_user_created" json:"userId"`
+	User           User             `gorm:"foreignKey:UserID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	Type           NotificationType `gorm:"type:varchar(64);not null" json:"type"`
+	Title          string           `gorm:"type:varchar(255);not null" json:"title"`
+	Body           string           `gorm:"type:text" json:"body"`
+	OrganizationID *uint            `json:"organizationId"`
+	TargetID       *uint            `json:"targetId"`
+	ReadAt         *time.Time       `gorm:"index" json:"readAt"`
+	CreatedAt      time.Time        `gorm:"index:idx_notification_user_created" json:"createdAt"`
+}
+
+func NewNotification(userID uuid.UUID, message NotificationMessage) Notification {
+	return Notification{
+		UserID:         userID,
+		Type:           message.Type,
+		Title:          message.Title,
+		Body:           message.Body,
+		OrganizationID: message.OrganizationID,
+		TargetID:       message.TargetID,
+	}
+}
+
+//---------------------------------------------------------------------------
+// Interfaces
+//---------------------------------------------------------------------------
+
+type NotificationRepository interface {
+	CreateMany(notifications []Notification) error
+	// ListByUserID returns a page of the user's notifications, newest first
+	ListByUserID(userID uuid.UUID, unreadOnly bool, page int, size int) ([]Notification, error)
+	CountUnread(userID uuid.UUID) (int64, error)
+	MarkRead(userID uuid.UUID, id uint) error
+	// MarkAllRead returns how many notifications were unread
+	MarkAllRead(userID uuid.UUID) (int64, error)
+}
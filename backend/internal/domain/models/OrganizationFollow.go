@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	FeedItemEvent = "event"
+	FeedItemJob   = "job"
+)
+
+// OrganizationFollow subscribes a user to what an organization publishes
+type OrganizationFollow struct {
+	UserID         uuid.UUID    `gorm:"type:uuid;primaryKey" json:"userId"`
+	User           User         `gorm:"foreignKey:UserID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	OrganizationID uint         `gorm:"primaryKey;autoIncrement:false;index" json:"organizationId"`
+	Organization   Organization `gorm:"foreignKey:OrganizationID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	CreatedAt      time.Time    `json:"createdAt"`
+}
+
+// FeedCursor is the position of the last feed item a client has seen. The feed is ordered by
+// PublishedAt, then Kind, then ID, all descending.
+type FeedCursor struct {
+	PublishedAt time.Time
+	Kind        string
+	ID          uint
+}
+
+//---------------------------------------------------------------------------
+// Interfaces
+//---------------------------------------------------------------------------
+
+type OrganizationFollowRepository interface {
+	// Follow is a no-op when the user already follows the organization
+	Follow(follow *OrganizationFollow) error
+	Unfollow(userID uuid.UUID, orgID uint) error
+	ListFollowedOrganizations(userID uuid.UUID) ([]Organization, error)
+	ListFollowerIDs(orgID uint) ([]uuid.UUID, error)
+	// ListFeedEvents returns up to limit published events of followed organizations after the cursor
+	ListFeedEvents(userID uuid.UUID, cursor *FeedCursor, limit int) ([]Event, error)
+	// ListFeedJobs returns up to limit published jobs of followed organizations after the cursor
+	ListFeedJobs(userID uuid.UUID, cursor *FeedCursor, limit int) ([]OrgOpenJob, error)
+}
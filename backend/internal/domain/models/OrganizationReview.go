@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OrganizationReview records a decision of a system admin on an organization waiting for review.
+// Decision is the status the organization was moved to.
+type OrganizationReview struct {
+	ID             uint         `gorm:"primaryKey" json:"id"`
+	OrganizationID uint         `gorm:"not null;index" json:"organizationId"`
+	Organization   Organization `gorm:"foreignKey:OrganizationID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	ReviewerID     uuid.UUID    `gorm:"type:uuid;not null" json:"reviewerId"`
+	Reviewer       User         `gorm:"foreignKey:ReviewerID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	Decision       string       `gorm:"type:varchar(50);not null" json:"decision"`
+	Reason         string       `gorm:"type:text" json:"reason"`
+	CreatedAt      time.Time    `json:"createdAt"`
+}
+
+//---------------------------------------------------------------------------
+// Interfaces
+//---------------------------------------------------------------------------
+
+type OrganizationReviewRepository interface {
+	// Create moves the organization to review.Decision and records the review in one transaction.
+	// It returns gorm.ErrRecordNotFound when the organization is no longer in fromStatus.
+	Create(review *OrganizationReview, fromStatus string) error
+	ListOrganizationsByStatus(status string, page uint, size uint) ([]Organization, error)
+	// ListByOrganizationID returns the reviews of the organization, newest first
+	ListByOrganizationID(orgID uint) ([]OrganizationReview, error)
+}
@@ -0,0 +1,22 @@
+package models
+
+// PlatformStats is a snapshot of platform activity for system admins.
+// The ByStatus maps are keyed by the status column of each table.
+type PlatformStats struct {
+	Users                 int64
+	SuspendedUsers        int64
+	NewUsersLast30Days    int64
+	OrganizationsByStatus map[string]int64
+	EventsByStatus        map[string]int64
+	JobsByStatus          map[string]int64
+	Applications          int64
+	TicketsPurchased      int64
+}
+
+//---------------------------------------------------------------------------
+// Interfaces
+//---------------------------------------------------------------------------
+
+type PlatformStatsRepository interface {
+	GetPlatformStats() (*PlatformStats, error)
+}
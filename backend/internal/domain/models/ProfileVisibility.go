@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProfileVisibility decides which parts of a profile are shown on the public
+// /users/:id/profile page. Users without a row get DefaultProfileVisibility.
+type ProfileVisibility struct {
+	UserID          uuid.UUID `gorm:"type:uuid;primaryKey" json:"userId"`
+	User            User      `gorm:"foreignKey:UserID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	ShowHeadline    bool      `gorm:"not null" json:"showHeadline"`
+	ShowBio         bool      `gorm:"not null" json:"showBio"`
+	ShowSkills      bool      `gorm:"not null" json:"showSkills"`
+	ShowExperiences bool      `gorm:"not null" json:"showExperiences"`
+	ShowEvents      bool      `gorm:"not null" json:"showEvents"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+// DefaultProfileVisibility shows the professional parts of a profile and keeps
+// event participation private until the user opts in.
+func DefaultProfileVisibility(userID uuid.UUID) ProfileVisibility {
+	return ProfileVisibility{
+		UserID:          userID,
+		ShowHeadline:    true,
+		ShowBio:         true,
+		ShowSkills:      true,
+		ShowExperiences: true,
+		ShowEvents:      false,
+	}
+}
+
+//---------------------------------------------------------------------------
+// Interfaces
+//---------------------------------------------------------------------------
+
+type ProfileVisibilityRepository interface {
+	GetByUserID(userID uuid.UUID) (*ProfileVisibility, error)
+	Upsert(visibility *ProfileVisibility) error
+}
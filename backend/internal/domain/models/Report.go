@@ -0,0 +1,101 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReportTargetType string
+type ReportStatus string
+type ModerationAction string
+
+const (
+	ReportTargetEvent        ReportTargetType = "event"
+	ReportTargetJob          ReportTargetType = "job"
+	ReportTargetOrganization ReportTargetType = "organization"
+	ReportTargetUser         ReportTargetType = "user"
+)
+
+const (
+	ReportStatusOpen      ReportStatus = "open"
+	ReportStatusDismissed ReportStatus = "dismissed"
+	ReportStatusActioned  ReportStatus = "actioned"
+)
+
+const (
+	ModerationDismiss    ModerationAction = "dismiss"
+	ModerationHide       ModerationAction = "hide"
+	ModerationSuspendOrg ModerationAction = "suspend_organization"
+	// ModerationReinstate reverses a hide or a suspension
+	ModerationReinstate ModerationAction = "reinstate"
+)
+
+// Report is a user flagging an event, job, organization or user profile for review. TargetID is
+// the numeric ID of the target, or the UUID for a user, so it has no foreign key. A user can only
+// have one open report on the same target.
+type Report struct {
+	ID         uint                `gorm:"primaryKey" json:"id"`
+	ReporterID uuid.UUID           `gorm:"type:uuid;not null;uniqueIndex:idx_report_open_reporter_target,where:status = 'open'" json:"reporterId"`
+	Reporter   User                `gorm:"foreignKey:ReporterID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	TargetType ReportTargetType    `gorm:"type:varchar(16);not null;index:idx_report_target;uniqueIndex:idx_report_open_reporter_target,where:status = 'open'" json:"targetType"`
+	TargetID   string              `gorm:"type:varchar(64);not null;index:idx_report_target;uniqueIndex:idx_report_open_reporter_target,where:status = 'open'" json:"targetId"`
+	Reason     string              `gorm:"type:varchar(32);not null" json:"reason"`
+	Details    string              `gorm:"type:text" json:"details"`
+	Status     ReportStatus        `gorm:"type:varchar(16);not null;default:'open';index" json:"status"`
+	DecisionID *uint               `json:"decisionId"`
+	Decision   *ModerationDecision `gorm:"foreignKey:DecisionID" json:"decision,omitempty"`
+	CreatedAt  time.Time           `json:"createdAt"`
+}
+
+// ModerationDecision is the audit trail of moderation. Every decision a system admin makes on a
+// target is kept, along with the reports it closed.
+type ModerationDecision struct {
+	ID          uint             `gorm:"primaryKey" json:"id"`
+	ModeratorID uuid.UUID        `gorm:"type:uuid;not null;index" json:"moderatorId"`
+	Moderator   User             `gorm:"foreignKey:ModeratorID;constraint:onUpdate:CASCADE,onDelete:RESTRICT;" json:"-"`
+	Action      ModerationAction `gorm:"type:varchar(32);not null" json:"action"`
+	TargetType  ReportTargetType `gorm:"type:varchar(16);not null;index:idx_moderation_decision_target" json:"targetType"`
+	TargetID    string           `gorm:"type:varchar(64);not null;index:idx_moderation_decision_target" json:"targetId"`
+	// OrganizationID is the organization that was suspended, or that owns the hidden content
+	OrganizationID *uint `json:"organizationId"`
+	// OrgStatusBefore is the status of a suspended organization before the suspension, which
+	// reinstating it restores
+	OrgStatusBefore string    `gorm:"type:varchar(32)" json:"orgStatusBefore,omitempty"`
+	Note            string    `gorm:"type:text" json:"note"`
+	CreatedAt       time.Time `json:"createdAt"`
+}
+
+//---------------------------------------------------------------------------
+// Interfaces
+//---------------------------------------------------------------------------
+
+type ReportRepository interface {
+	Create(report *Report) error
+	GetByID(id uint) (*Report, error)
+	// List returns a page of reports in status, oldest first. An empty targetType lists every type.
+	List(status ReportStatus, targetType ReportTargetType, page int, size int) ([]Report, int64, error)
+	// ListDecisions returns the moderation history of a target, newest first
+	ListDecisions(targetType ReportTargetType, targetID string) ([]ModerationDecision, error)
+	TargetExists(targetType ReportTargetType, targetID string) (bool, error)
+	// TargetOrganizationID returns the organization an event, job or organization target belongs to
+	TargetOrganizationID(targetType ReportTargetType, targetID string) (uint, error)
+
+	// The moderation actions below record the decision and close every open report on its target
+	// in the same transaction as their effect.
+
+	Dismiss(decision *ModerationDecision) error
+	// Hide hides the event or job the decision targets
+	Hide(decision *ModerationDecision) error
+	// SuspendOrganization suspends decision.OrganizationID and records its status before in
+	// decision.OrgStatusBefore
+	SuspendOrganization(decision *ModerationDecision) error
+
+	// The reversals below record the decision without touching the reports, which stay actioned.
+	// They return gorm.ErrRecordNotFound when the target is not hidden or suspended anymore.
+
+	// Unhide makes the event or job the decision targets visible again
+	Unhide(decision *ModerationDecision) error
+	// ReinstateOrganization sets decision.OrganizationID back to status
+	ReinstateOrganization(decision *ModerationDecision, status string) error
+}
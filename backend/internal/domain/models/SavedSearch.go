@@ -0,0 +1,82 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type SavedSearchKind string
+
+const (
+	SavedSearchKindJob   SavedSearchKind = "job"
+	SavedSearchKindEvent SavedSearchKind = "event"
+)
+
+type DigestFrequency string
+
+const (
+	DigestFrequencyDaily  DigestFrequency = "daily"
+	DigestFrequencyWeekly DigestFrequency = "weekly"
+)
+
+// ErrSavedSearchAlreadyClaimed is returned when another scheduler run picked up the saved search first
+var ErrSavedSearchAlreadyClaimed = errors.New("saved search already claimed")
+
+// Interval is the time between two digests
+func (f DigestFrequency) Interval() time.Duration {
+	if f == DigestFrequencyWeekly {
+		return 7 * 24 * time.Hour
+	}
+	return 24 * time.Hour
+}
+
+// SavedSearch is a job or event search the user wants to be alerted about. Query holds the
+// dto.SearchJobQuery or dto.SearchQuery as JSON, depending on Kind.
+type SavedSearch struct {
+	gorm.Model
+	UserID           uuid.UUID       `gorm:"type:uuid;not null;index" json:"userId"`
+	User             User            `gorm:"foreignKey:UserID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	Name             string          `gorm:"type:varchar(255);not null" json:"name"`
+	Kind             SavedSearchKind `gorm:"type:varchar(16);not null" json:"kind"`
+	Query            string          `gorm:"type:jsonb;not null" json:"query"`
+	Frequency        DigestFrequency `gorm:"type:varchar(16);not null" json:"frequency"`
+	Subscribed       bool            `gorm:"not null" json:"subscribed"`
+	UnsubscribeToken string          `gorm:"type:varchar(64);not null;uniqueIndex" json:"-"`
+	LastRunAt        *time.Time      `json:"lastRunAt"`
+	NextRunAt        time.Time       `gorm:"not null;index" json:"nextRunAt"`
+}
+
+// SavedSearchHit remembers a job or event already sent for a saved search, so each digest only
+// carries results the user has not seen.
+type SavedSearchHit struct {
+	SavedSearchID uint        `gorm:"primaryKey" json:"savedSearchId"`
+	SavedSearch   SavedSearch `gorm:"foreignKey:SavedSearchID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	ItemID        uint        `gorm:"primaryKey;autoIncrement:false" json:"itemId"`
+	CreatedAt     time.Time   `json:"createdAt"`
+}
+
+//---------------------------------------------------------------------------
+// Interfaces
+//---------------------------------------------------------------------------
+
+type SavedSearchRepository interface {
+	Create(savedSearch *SavedSearch) error
+	GetByIDAndUserID(id uint, userID uuid.UUID) (*SavedSearch, error)
+	ListByUserID(userID uuid.UUID) ([]SavedSearch, error)
+	Update(savedSearch *SavedSearch) error
+	Delete(id uint, userID uuid.UUID) error
+	// Unsubscribe turns off the digest of the saved search owning the token
+	Unsubscribe(token string) error
+	// ListDue returns subscribed saved searches whose next run is at or before now, with their user
+	ListDue(now time.Time, limit int) ([]SavedSearch, error)
+	// Claim moves the next run of a due saved search forward, returning ErrSavedSearchAlreadyClaimed
+	// when its next run is no longer nextRunAt because another instance claimed it.
+	Claim(id uint, nextRunAt time.Time, newNextRunAt time.Time, lastRunAt time.Time) error
+	// UnseenHits returns the item IDs, in order, that were not recorded as seen yet
+	UnseenHits(savedSearchID uint, itemIDs []uint) ([]uint, error)
+	// RecordHits stores the item IDs as seen, ignoring the ones already recorded
+	RecordHits(savedSearchID uint, itemIDs []uint) error
+}
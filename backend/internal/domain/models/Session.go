@@ -0,0 +1,51 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrRefreshTokenReused is returned when a refresh token that was already rotated is presented again
+var ErrRefreshTokenReused = errors.New("refresh token reused")
+
+// UserSession is one logged-in device. Every access token carries the session ID,
+// so revoking the session invalidates its access and refresh tokens at once.
+type UserSession struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primaryKey" json:"id"`
+	UserID    uuid.UUID  `gorm:"type:uuid;not null;index" json:"userId"`
+	User      User       `gorm:"foreignKey:UserID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	UserAgent string     `gorm:"type:varchar(255)" json:"userAgent"`
+	IPAddress string     `gorm:"type:varchar(64)" json:"ipAddress"`
+	ExpiresAt time.Time  `gorm:"not null" json:"expiresAt"`
+	RevokedAt *time.Time `json:"revokedAt"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+}
+
+// RefreshToken belongs to a session. Only the SHA-256 hash of the token is stored.
+type RefreshToken struct {
+	ID        uuid.UUID   `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
+	SessionID uuid.UUID   `gorm:"type:uuid;not null;index" json:"sessionId"`
+	Session   UserSession `gorm:"foreignKey:SessionID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	TokenHash string      `gorm:"type:varchar(64);not null;uniqueIndex" json:"-"`
+	ExpiresAt time.Time   `gorm:"not null" json:"expiresAt"`
+	RotatedAt *time.Time  `json:"rotatedAt"`
+	CreatedAt time.Time   `json:"createdAt"`
+}
+
+//---------------------------------------------------------------------------
+// Interfaces
+//---------------------------------------------------------------------------
+
+type SessionRepository interface {
+	Create(session *UserSession, refreshToken *RefreshToken) error
+	GetSessionByID(id uuid.UUID) (*UserSession, error)
+	GetRefreshTokenByHash(tokenHash string) (*RefreshToken, error)
+	// Rotate marks the old token as used, stores its replacement and extends the session
+	// in one transaction, returning ErrRefreshTokenReused when the old token was already rotated.
+	Rotate(oldTokenID uuid.UUID, newToken *RefreshToken) error
+	RevokeSession(id uuid.UUID) error
+	RevokeAllByUserID(userID uuid.UUID) error
+}
@@ -1,10 +1,15 @@
 package models
 
 import (
+	"errors"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
+// ErrTicketSoldOut is returned when no ticket is left to decrement
+var ErrTicketSoldOut = errors.New("ticket sold out")
+
 type TicketPurchased struct {
 	gorm.Model
 	UserID            uuid.UUID       `gorm:"type:uuid;not null" json:"userId"`
@@ -17,5 +22,30 @@ type TicketPurchased struct {
 	Phone             string          `gorm:"type:varchar(20)" json:"phone"`
 	TicketTitle       string          `gorm:"type:varchar(255)" json:"ticketTitle"`
 	ConfirmationAt    string          `gorm:"type:varchar(255)" json:"confirmationAt"`
-	Qrcode            string          `gorm:"type:varchar(255)" json:"qrcode"`
+	Qrcode            string          `gorm:"type:varchar(255);uniqueIndex" json:"qrcode"`
+}
+
+//---------------------------------------------------------------------------
+// Interfaces
+//---------------------------------------------------------------------------
+
+type TicketPurchasedRepository interface {
+	// Purchase decrements the ticket quantity and stores the purchase in one transaction,
+	// returning ErrTicketSoldOut when the quantity is already zero.
+	Purchase(purchase *TicketPurchased) error
+	GetByID(id uint) (*TicketPurchased, error)
+	GetAllByUserID(userID uuid.UUID) ([]TicketPurchased, error)
+	GetByQrcode(qrcode string) (*TicketPurchased, error)
+	// CheckIn sets ConfirmationAt only when it is still empty, returning
+	// gorm.ErrRecordNotFound when no unused ticket matches.
+	CheckIn(eventID uint, qrcode string, confirmationAt string) error
+	CountByEventID(eventID uint) (total int64, checkedIn int64, err error)
+}
+
+type TicketPurchasedService interface {
+	Purchase(userID uuid.UUID, eventID uint, ticketAvailableID uint, phone string) (*TicketPurchased, error)
+	GetByIDAndUserID(userID uuid.UUID, id uint) (*TicketPurchased, error)
+	GetAllByUserID(userID uuid.UUID) ([]TicketPurchased, error)
+	CheckIn(orgID uint, eventID uint, qrcode string) (*TicketPurchased, error)
+	GetAttendance(orgID uint, eventID uint) (total int64, checkedIn int64, err error)
 }
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrLastLoginMethod is returned when removing a login method would leave the user unable to sign in
+	ErrLastLoginMethod = errors.New("cannot remove the last login method")
+	// ErrPasswordAlreadySet is returned when linking a password to a user who already has one
+	ErrPasswordAlreadySet = errors.New("password already set")
+)
+
+// UserIdentity links a user to an account at an external login provider. Password
+// login is not an identity; it is enabled whenever User.Password is set.
+type UserIdentity struct {
+	ID         uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
+	UserID     uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_user_identity_user_provider" json:"userId"`
+	User       User      `gorm:"foreignKey:UserID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	Provider   Provider  `gorm:"type:varchar(32);not null;uniqueIndex:idx_user_identity_user_provider;uniqueIndex:idx_user_identity_provider_subject" json:"provider"`
+	ProviderID string    `gorm:"type:varchar(255);not null;uniqueIndex:idx_user_identity_provider_subject" json:"providerId"`
+	Email      string    `gorm:"type:varchar(255)" json:"email"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+//---------------------------------------------------------------------------
+// Interfaces
+//---------------------------------------------------------------------------
+
+type UserIdentityRepository interface {
+	GetByProvider(provider Provider, providerID string) (*UserIdentity, error)
+	GetAllByUserID(userID uuid.UUID) ([]UserIdentity, error)
+	Create(identity *UserIdentity) error
+	// CreateWithUser signs a new user up through a provider: the user, its profile and the
+	// identity are created in one transaction
+	CreateWithUser(user *User, profile *Profile, identity *UserIdentity) error
+	// Delete and RemovePassword lock the user row and return ErrLastLoginMethod
+	// instead of removing the only remaining way to sign in.
+	Delete(userID uuid.UUID, provider Provider) error
+	RemovePassword(userID uuid.UUID) error
+	SetPassword(userID uuid.UUID, hashedPassword string) error
+}
+
+type UserIdentityService interface {
+	ListLoginMethods(userID uuid.UUID) (bool, []UserIdentity, error)
+	LinkPassword(userID uuid.UUID, password string) error
+	UnlinkPassword(userID uuid.UUID) error
+	LinkProvider(userID uuid.UUID, provider Provider, providerID string, email string) (*UserIdentity, error)
+	UnlinkProvider(userID uuid.UUID, provider Provider) error
+}
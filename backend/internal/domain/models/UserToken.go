@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UserTokenPurpose string
+
+const (
+	// Enum UserTokenPurpose
+	UserTokenVerifyEmail   UserTokenPurpose = "verify_email"
+	UserTokenResetPassword UserTokenPurpose = "reset_password"
+)
+
+// UserToken is a single-use, expiring token mailed to a user. Only the HMAC of the
+// token is stored, so a leaked table cannot be used to verify emails or reset passwords.
+type UserToken struct {
+	ID        uuid.UUID        `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" json:"id"`
+	UserID    uuid.UUID        `gorm:"type:uuid;not null;index" json:"userId"`
+	User      User             `gorm:"foreignKey:UserID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" json:"-"`
+	Purpose   UserTokenPurpose `gorm:"type:varchar(32);not null" json:"purpose"`
+	TokenHash string           `gorm:"type:varchar(64);not null;uniqueIndex" json:"-"`
+	ExpiresAt time.Time        `gorm:"not null" json:"expiresAt"`
+	UsedAt    *time.Time       `json:"usedAt"`
+	CreatedAt time.Time        `json:"createdAt"`
+}
+
+//---------------------------------------------------------------------------
+// Interfaces
+//---------------------------------------------------------------------------
+
+type UserTokenRepository interface {
+	// Create stores the token and discards any unused token the user holds for the same purpose
+	Create(token *UserToken) error
+	// VerifyEmail consumes a verification token and marks the user's email as verified
+	VerifyEmail(tokenHash string) (*UserToken, error)
+	// ResetPassword consumes a reset token, replaces the user's password and marks the email as verified
+	ResetPassword(tokenHash string, hashedPassword string) (*UserToken, error)
+}
@@ -15,3 +15,16 @@ type Category struct {
 	Jobs          []OrgOpenJob `gorm:"many2many:category_job;"`
 	Events        []Event      `gorm:"many2many:category_event;"`
 }
+
+//---------------------------------------------------------------------------
+// Interfaces
+//---------------------------------------------------------------------------
+
+type CategoryRepository interface {
+	// List returns every category, including inactive ones, in sort order
+	List() ([]Category, error)
+	GetByID(id uint) (*Category, error)
+	Create(category *Category) error
+	Update(category *Category) error
+	Delete(id uint) error
+}
@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	"github.com/DAF-Bridge/asaiasa-Backend/utils"
 	"gorm.io/gorm"
 )
@@ -73,7 +75,10 @@ type Event struct {
 	Audience        string            `gorm:"type:varchar(50)" db:"audience" json:"audience"`
 	PriceType       string            `gorm:"type:varchar(50)" db:"price_type" json:"priceType"`
 	RegisterLink    string            `gorm:"type:varchar(255)" db:"register_link"`
+	Capacity        int               `gorm:"default:0;check:capacity >= 0" db:"capacity"` // 0 means unlimited
 	Status          string            `gorm:"type:varchar(50)" db:"status"`
+	PublishedAt     *time.Time        `gorm:"index" db:"published_at"`
+	HiddenAt        *time.Time        `gorm:"index" db:"hidden_at"` // Set when a system admin hides the event after a report
 	ContactChannels []ContactChannel  `gorm:"foreignKey:EventID;references:ID" db:"contact_channels"`
 	Categories      []Category        `gorm:"many2many:category_event;"`
 	OrganizationID  uint              `gorm:"not null" db:"organization_id"`
@@ -81,6 +86,12 @@ type Event struct {
 	TicketAvailable []TicketAvailable `gorm:"foreignKey:EventID;constraint:onUpdate:CASCADE,onDelete:CASCADE;" db:"ticket_available"`
 }
 
+// VisibleToPublic reports whether the event may be served outside its organization: it is not
+// hidden by moderation and its organization, which must be preloaded, is approved
+func (e Event) VisibleToPublic() bool {
+	return e.HiddenAt == nil && e.Organization.Status == OrgStatusApproved
+}
+
 type TicketAvailable struct {
 	gorm.Model
 	Title       string  `gorm:"type:varchar(255);not null" db:"title"`
@@ -100,17 +111,21 @@ type TicketAvailable struct {
 type TicketAvailableRepository interface {
 	GetByID(id uint) (*TicketAvailable, error)
 	GetAll() ([]TicketAvailable, error)
+	GetAllByEventID(eventID uint) ([]TicketAvailable, error)
 	Create(ticketAvailable *TicketAvailable) error
-	// Update(ticketAvailable *TicketAvailable) error
-	// Delete(id uint) error
+	Update(ticketAvailable *TicketAvailable) error
+	Delete(id uint) error
 }
 
 type TicketAvailableService interface {
 	GetByID(id uint) (*TicketAvailable, error)
 	GetAll() ([]TicketAvailable, error)
-	Create(ticketAvailable *TicketAvailable) error
-	// Update(ticketAvailable *TicketAvailable) error
-	// Delete(id uint) error
+	GetAllByEventID(eventID uint) ([]TicketAvailable, error)
+	// GetAllByEventIDwithOrgID lists the tickets of an event of the organization, hidden or not
+	GetAllByEventIDwithOrgID(orgID uint, eventID uint) ([]TicketAvailable, error)
+	Create(orgID uint, eventID uint, ticketAvailable *TicketAvailable) error
+	Update(orgID uint, eventID uint, ticketAvailable *TicketAvailable) error
+	Delete(orgID uint, eventID uint, id uint) error
 }
 
 // ----------- Mock Event ----------- //
@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 )
 
@@ -52,6 +54,27 @@ const (
 	JobStatusArchived  JobStatus = "archived"
 )
 
+// Review states of an organization. Only approved organizations are listed publicly.
+const (
+	OrgStatusPending          = "pending"
+	OrgStatusApproved         = "approved"
+	OrgStatusRejected         = "rejected"
+	OrgStatusChangesRequested = "changes_requested"
+	// OrgStatusSuspended is set by moderation; the organization and its content are taken down
+	OrgStatusSuspended = "suspended"
+)
+
+// PublishedAtFor returns the publish time to store for an event or job saved with status.
+// The first publish is kept, so editing or re-publishing does not move the item up the feed.
+func PublishedAtFor(previous *time.Time, status string) *time.Time {
+	if previous != nil || status != string(JobStatusPublished) {
+		return previous
+	}
+
+	now := time.Now()
+	return &now
+}
+
 //---------------------------------------------------------------------------
 // Models
 //---------------------------------------------------------------------------
@@ -116,10 +139,18 @@ type OrgOpenJob struct {
 	Quantity       int            `json:"quantity" example:"1"`
 	RegisterLink   string         `gorm:"type:text" db:"register_link"`
 	Status         string         `gorm:"type:varchar(50);default:'draft'" json:"status" example:"draft"`
+	PublishedAt    *time.Time     `gorm:"index" json:"publishedAt"`
+	HiddenAt       *time.Time     `gorm:"index" json:"hiddenAt"`                                          // Set when a system admin hides the job after a report
 	Prerequisites  []Prerequisite `gorm:"foreignKey:JobID;constraint:onUpdate:CASCADE,onDelete:CASCADE;"` // Job prerequisites
 	Categories     []Category     `gorm:"many2many:category_job;constraint:OnDelete:CASCADE;"`
 }
 
+// VisibleToPublic reports whether the job may be served outside its organization, see
+// Event.VisibleToPublic
+func (j OrgOpenJob) VisibleToPublic() bool {
+	return j.HiddenAt == nil && j.Organization.Status == OrgStatusApproved
+}
+
 type Prerequisite struct {
 	gorm.Model
 	JobID uint       `gorm:"not null" json:"jobId"`
@@ -127,3 +158,16 @@ type Prerequisite struct {
 	Title string     `gorm:"type:varchar(255);not null" json:"name"`
 	Link  string     `gorm:"type:text;not null" json:"link"`
 }
+
+//---------------------------------------------------------------------------
+// Interfaces
+//---------------------------------------------------------------------------
+
+type IndustryRepository interface {
+	List() ([]Industry, error)
+	GetByID(id uint) (*Industry, error)
+	Create(industry *Industry) error
+	Update(industry *Industry) error
+	// Delete removes the industry and unlinks it from its organizations
+	Delete(id uint) error
+}
@@ -32,8 +32,8 @@ type Experience struct {
 	UUID        uuid.UUID      `gorm:"type:uuid;default:uuid_generate_v4();primarykey" db:"uuid"`
 	ProfileID   uint           `gorm:"type:uint;not null" db:"profile_id"`
 	Currently   bool           `gorm:"default:false;not null" db:"currently"`
-	StartDate   time.Time      `gorm:"time:DATE" db:"start_date"`
-	EndDate     time.Time      `gorm:"time:DATE" db:"end_date"`
+	StartDate   time.Time      `gorm:"type:date;not null" db:"start_date"`
+	EndDate     *time.Time     `gorm:"type:date" db:"end_date"` // nil while Currently is true
 	Title       string         `gorm:"type:varchar(255);not null" db:"title"`
 	PicUrl      string         `gorm:"type:varchar(255)" db:"pic_url"`
 	Description string         `gorm:"type:text" db:"description"`
@@ -52,13 +52,14 @@ type ProfileService interface {
 	Update(profile *Profile) error
 }
 
-// ExperienceService
+// ExperienceService manages the work history on a user's profile. Every method is
+// scoped to the user, so an experience of another profile is reported as not found.
 type ExperienceService interface {
-	CreateExperience(experience *Experience) error
+	CreateExperience(userID uuid.UUID, experience *Experience) error
 	ListExperiencesByUserID(userID uuid.UUID) ([]Experience, error)
-	GetExperienceByID(experienceID uuid.UUID) (*Experience, error)
-	UpdateExperience(experience *Experience) error
-	DeleteExperience(experienceID uuid.UUID) error
+	GetExperienceByID(userID uuid.UUID, experienceID uuid.UUID) (*Experience, error)
+	UpdateExperience(userID uuid.UUID, experience *Experience) error
+	DeleteExperience(userID uuid.UUID, experienceID uuid.UUID) error
 }
 
 //---------------------------------------------------------------------------
@@ -32,18 +32,19 @@ const (
 //---------------------------------------------------------------------------
 
 type User struct {
-	ID          uuid.UUID      `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" db:"id"`
-	Name        string         `gorm:"type:varchar(255);not null" db:"name"`
-	PicUrl      string         `gorm:"type:text;" db:"pic_url"`
-	Email       string         `gorm:"type:varchar(255);not null" db:"email"`
-	Password    *string        `gorm:"type:varchar(255)" db:"-"` // Hashed password for traditional login
-	Role        Role           `gorm:"type:Role;default:'User'" db:"role"`
-	Provider    Provider       `gorm:"type:Provider;not null" db:"provider"` // e.g., "google"
-	ProviderID  string         `gorm:"type:varchar(255);not null" db:"provider_id"`
-	Preferences UserPreference `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE;"`
-	CreatedAt   time.Time      `gorm:"autoCreateTime" db:"created_at"`
-	UpdatedAt   time.Time      `gorm:"autoUpdateTime" db:"updated_at"`
-	DeletedAt   gorm.DeletedAt `gorm:"index" db:"deleted_at"`
+	ID              uuid.UUID      `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" db:"id"`
+	Name            string         `gorm:"type:varchar(255);not null" db:"name"`
+	PicUrl          string         `gorm:"type:text;" db:"pic_url"`
+	Email           string         `gorm:"type:varchar(255);not null" db:"email"`
+	Password        *string        `gorm:"type:varchar(255)" db:"-"` // Hashed password for traditional login
+	EmailVerifiedAt *time.Time     `db:"email_verified_at"`
+	Role            Role           `gorm:"type:Role;default:'User'" db:"role"`
+	SuspendedAt     *time.Time     `gorm:"index" db:"suspended_at"` // Set by a system admin; a suspended user cannot log in
+	SuspendedReason string         `gorm:"type:text" db:"suspended_reason"`
+	Preferences     UserPreference `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE;"`
+	CreatedAt       time.Time      `gorm:"autoCreateTime" db:"created_at"`
+	UpdatedAt       time.Time      `gorm:"autoUpdateTime" db:"updated_at"`
+	DeletedAt       gorm.DeletedAt `gorm:"index" db:"deleted_at"`
 }
 
 type UserPreference struct {
@@ -0,0 +1,323 @@
+package handler
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ApplicationHandler struct {
+	service models.ApplicationService
+}
+
+func NewApplicationHandler(service models.ApplicationService) *ApplicationHandler {
+	return &ApplicationHandler{service: service}
+}
+
+// @Summary Apply to a job
+// @Description Submit an application with an optional cover note and a resume (PDF or Word, at most 5 MB)
+// @Tags Applications
+// @Accept multipart/form-data
+// @Produce json
+// @Param jobID path int true "Job ID"
+// @Param coverNote formData string false "Cover note"
+// @Param resume formData file true "Resume"
+// @Success 201 {object} dto.ApplicationResponse
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 404 {object} map[string]string "error: job not found"
+// @Failure 409 {object} map[string]string "error: you have already applied to this job"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /jobs/{jobID}/apply [post]
+func (h *ApplicationHandler) Apply(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+	jobID, err := utils.GetJobIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	fileHeader, err := c.FormFile("resume")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "resume is required"})
+	}
+
+	src, err := fileHeader.Open()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to open file"})
+	}
+	defer src.Close()
+
+	application, err := h.service.Apply(c.Context(), userID, jobID, c.FormValue("coverNote"), src, fileHeader)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(dto.BuildApplicationResponse(*application))
+}
+
+// @Summary List my applications
+// @Description List the job applications of the current user, newest first
+// @Tags Applications
+// @Produce json
+// @Success 200 {array} dto.ApplicationResponse
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/applications [get]
+func (h *ApplicationHandler) ListMyApplications(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	applications, err := h.service.ListMyApplications(userID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.BuildApplicationResponses(applications))
+}
+
+// @Summary Get one of my applications
+// @Description Get an application of the current user with its status history
+// @Tags Applications
+// @Produce json
+// @Param id path int true "Application ID"
+// @Success 200 {object} dto.ApplicationResponse
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 404 {object} map[string]string "error: application not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/applications/{id} [get]
+func (h *ApplicationHandler) GetMyApplication(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+	applicationID, err := utils.GetParamFormFiberCtx(c, "id", "application")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	application, err := h.service.GetMyApplication(userID, applicationID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.BuildApplicationResponse(*application))
+}
+
+// @Summary Get the resume of one of my applications
+// @Description Get a download link for the resume, valid for 15 minutes
+// @Tags Applications
+// @Produce json
+// @Param id path int true "Application ID"
+// @Success 200 {object} map[string]string "url: presigned download link"
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 404 {object} map[string]string "error: application not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/applications/{id}/resume [get]
+func (h *ApplicationHandler) GetMyResume(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+	applicationID, err := utils.GetParamFormFiberCtx(c, "id", "application")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	application, err := h.service.GetMyApplication(userID, applicationID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return h.sendResumeURL(c, application)
+}
+
+// @Summary Withdraw an application
+// @Description Withdraw one of the current user's applications while it is still in progress
+// @Tags Applications
+// @Produce json
+// @Param id path int true "Application ID"
+// @Success 200 {object} dto.ApplicationResponse
+// @Failure 400 {object} map[string]string "error: the application can no longer be withdrawn"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 404 {object} map[string]string "error: application not found"
+// @Failure 409 {object} map[string]string "error: the application was updated in the meantime"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/applications/{id}/withdraw [post]
+func (h *ApplicationHandler) Withdraw(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+	applicationID, err := utils.GetParamFormFiberCtx(c, "id", "application")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	application, err := h.service.Withdraw(userID, applicationID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.BuildApplicationResponse(*application))
+}
+
+// @Summary List applications to a job
+// @Description List the applications to an organization's job, optionally filtered by status
+// @Tags Applications
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Param jobID path int true "Job ID"
+// @Param status query string false "Status" Enums(submitted, reviewing, interview, offered, rejected, withdrawn)
+// @Success 200 {array} dto.ApplicantApplicationResponse
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: job not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/jobs/{jobID}/applications [get]
+func (h *ApplicationHandler) ListJobApplications(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	jobID, err := utils.GetJobIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	applications, err := h.service.ListJobApplications(orgID, jobID, models.ApplicationStatus(c.Query("status")))
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.BuildApplicantApplicationResponses(applications))
+}
+
+// @Summary Get an application to a job
+// @Description Get an application to an organization's job with its status history
+// @Tags Applications
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Param jobID path int true "Job ID"
+// @Param id path int true "Application ID"
+// @Success 200 {object} dto.ApplicantApplicationResponse
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: application not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/jobs/{jobID}/applications/{id} [get]
+func (h *ApplicationHandler) GetJobApplication(c *fiber.Ctx) error {
+	application, err := h.jobApplicationFromCtx(c)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.BuildApplicantApplicationResponse(*application))
+}
+
+// @Summary Get an applicant's resume
+// @Description Get a download link for the resume of an application, valid for 15 minutes
+// @Tags Applications
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Param jobID path int true "Job ID"
+// @Param id path int true "Application ID"
+// @Success 200 {object} map[string]string "url: presigned download link"
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: application not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/jobs/{jobID}/applications/{id}/resume [get]
+func (h *ApplicationHandler) GetApplicantResume(c *fiber.Ctx) error {
+	application, err := h.jobApplicationFromCtx(c)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return h.sendResumeURL(c, application)
+}
+
+// @Summary Move an application through the pipeline
+// @Description Change the status of an application: submitted → reviewing → interview → offered, or rejected at any step
+// @Tags Applications
+// @Accept json
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Param jobID path int true "Job ID"
+// @Param id path int true "Application ID"
+// @Param body body dto.UpdateApplicationStatusRequest true "New status"
+// @Success 200 {object} dto.ApplicantApplicationResponse
+// @Failure 400 {object} map[string]string "error: cannot move an application from submitted to offered"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: application not found"
+// @Failure 409 {object} map[string]string "error: the application was updated in the meantime"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/jobs/{jobID}/applications/{id}/status [patch]
+func (h *ApplicationHandler) UpdateStatus(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	jobID, err := utils.GetJobIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	applicationID, err := utils.GetParamFormFiberCtx(c, "id", "application")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.UpdateApplicationStatusRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	application, err := h.service.UpdateStatus(orgID, jobID, applicationID, userID, models.ApplicationStatus(req.Status), req.Note)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.BuildApplicantApplicationResponse(*application))
+}
+
+func (h *ApplicationHandler) jobApplicationFromCtx(c *fiber.Ctx) (*models.Application, error) {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return nil, errs.NewBadRequestError(err.Error())
+	}
+	jobID, err := utils.GetJobIDFormFiberCtx(c)
+	if err != nil {
+		return nil, errs.NewBadRequestError(err.Error())
+	}
+	applicationID, err := utils.GetParamFormFiberCtx(c, "id", "application")
+	if err != nil {
+		return nil, errs.NewBadRequestError(err.Error())
+	}
+
+	return h.service.GetJobApplication(orgID, jobID, applicationID)
+}
+
+func (h *ApplicationHandler) sendResumeURL(c *fiber.Ctx, application *models.Application) error {
+	url, err := h.service.ResumeURL(c.Context(), application)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"url": url})
+}
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type AuditLogHandler struct {
+	service service.AuditLogService
+}
+
+func NewAuditLogHandler(service service.AuditLogService) *AuditLogHandler {
+	return &AuditLogHandler{service: service}
+}
+
+// @Summary List the audit log of an organization
+// @Description List who changed what in the organization through the admin API, newest first, 20 per page
+// @Tags Organization Audit Log
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Param actorId query string false "Only changes made by this user"
+// @Param resource query string false "Only changes to this resource, e.g. Event"
+// @Param action query string false "Only this action, e.g. update"
+// @Param from query string false "Only changes at or after this time, RFC 3339 or YYYY-MM-DD"
+// @Param to query string false "Only changes before this time, RFC 3339 or YYYY-MM-DD (the whole day is included)"
+// @Param page query int false "Page number"
+// @Success 200 {object} dto.PaginatedAuditLogResponse
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/audit-log [get]
+func (h *AuditLogHandler) ListAuditLogs(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	page := c.QueryInt("page", 1)
+	if page < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid page"})
+	}
+
+	filter := models.AuditLogFilter{Resource: c.Query("resource"), Action: c.Query("action")}
+	if actor := c.Query("actorId"); actor != "" {
+		actorID, err := uuid.Parse(actor)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid user ID"})
+		}
+		filter.ActorID = &actorID
+	}
+	if filter.From, err = parseAuditLogTime(c.Query("from"), false); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid from"})
+	}
+	if filter.To, err = parseAuditLogTime(c.Query("to"), true); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid to"})
+	}
+
+	auditLogs, err := h.service.ListAuditLogs(orgID, filter, page)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(auditLogs)
+}
+
+// parseAuditLogTime reads an RFC 3339 time or a date. A date used as the end of a range means the
+// end of that day.
+func parseAuditLogTime(value string, endOfDay bool) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t, nil
+	}
+
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, err
+	}
+	if endOfDay {
+		t = t.AddDate(0, 0, 1)
+	}
+	return &t, nil
+}
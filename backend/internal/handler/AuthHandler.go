@@ -5,17 +5,20 @@ import (
 	"time"
 
 	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
 	"github.com/DAF-Bridge/asaiasa-Backend/logs"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
 	"github.com/gofiber/fiber/v2"
 )
 
 type AuthHandler struct {
-	authService *service.AuthService
+	authService    *service.AuthService
+	sessionService service.SessionService
 }
 
-func NewAuthHandler(authService *service.AuthService) *AuthHandler {
-	return &AuthHandler{authService: authService}
+func NewAuthHandler(authService *service.AuthService, sessionService service.SessionService) *AuthHandler {
+	return &AuthHandler{authService: authService, sessionService: sessionService}
 }
 
 type SignUpHandlerRequest struct {
@@ -33,28 +36,20 @@ func (a *AuthHandler) SignUp(c *fiber.Ctx) error {
 	}
 
 	// Generate token
-	token, err := a.authService.SignUp(req.Name, req.Email, req.Password, req.Phone)
+	tokens, err := a.authService.SignUp(req.Name, req.Email, req.Password, req.Phone, sessionClientFromCtx(c))
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
 	}
 
+	// Login is withheld until the email is verified
+	if tokens == nil {
+		return c.Status(fiber.StatusCreated).JSON(fiber.Map{"message": "Sign up successful, please verify your email"})
+	}
+
 	// Set cookie for backward compatibility (optional)
-	c.Cookie(&fiber.Cookie{
-		Name:     "authToken",
-		Value:    token,                              // Token from the auth service
-		Expires:  time.Now().Add(time.Hour * 24 * 7), // Set expiration for 7 days
-		HTTPOnly: true,                               // Prevent JavaScript access to the cookie
-		Secure:   os.Getenv("ENVIRONMENT") != "dev",  // Only send the cookie over HTTPS in production
-		SameSite: fiber.CookieSameSiteNoneMode,       // Allow cross-site cookie sharing
-		Path:     "/",                                // Path for which the cookie is valid
-		Domain:   os.Getenv("COOKIE_DOMAIN"),         // Domain for which the cookie is valid
-	})
+	setAuthCookies(c, tokens, os.Getenv("COOKIE_DOMAIN"))
 
-	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
-		"message":    "Sign up successful",
-		"token":      token,
-		"token_type": "Bearer",
-	})
+	return c.Status(fiber.StatusCreated).JSON(buildAuthTokensResponse("Sign up successful", tokens))
 }
 
 func (a *AuthHandler) LogIn(c *fiber.Ctx) error {
@@ -68,46 +63,156 @@ func (a *AuthHandler) LogIn(c *fiber.Ctx) error {
 	}
 
 	// Generate token
-	token, err := a.authService.LogIn(req.Email, req.Password)
+	tokens, err := a.authService.LogIn(req.Email, req.Password, sessionClientFromCtx(c))
 	if err != nil {
 		logs.Error(err.Error())
 		return errs.SendFiberError(c, err)
 	}
 
 	// Set cookie for backward compatibility (optional)
-	c.Cookie(&fiber.Cookie{
-		Name:     "authToken",
-		Value:    token,                              // Token from the auth service
-		Expires:  time.Now().Add(time.Hour * 24 * 7), // Set expiration for 7 days
-		HTTPOnly: true,                               // Prevent JavaScript access to the cookie
-		Secure:   os.Getenv("ENVIRONMENT") != "dev",  // Only send the cookie over HTTPS in production
-		SameSite: fiber.CookieSameSiteNoneMode,       // Allow cross-site cookie sharing
-		Path:     "/",                                // Path for which the cookie is valid
-		Domain:   os.Getenv("COOKIE_DOMAIN"),         // Domain for which the cookie is valid
-	})
+	setAuthCookies(c, tokens, os.Getenv("COOKIE_DOMAIN"))
 
 	// Send response with token for Bearer authentication
-	return c.Status(fiber.StatusOK).JSON(fiber.Map{
-		"message":    "Login successful",
-		"token":      token,
-		"token_type": "Bearer",
-	})
+	return c.Status(fiber.StatusOK).JSON(buildAuthTokensResponse("Login successful", tokens))
+}
+
+// @Summary Refresh the access token
+// @Description Exchange a refresh token (body or refreshToken cookie) for a new token pair. Each refresh token can be used once; reusing one revokes the session.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body dto.RefreshTokenRequest false "Refresh token, if not sent as a cookie"
+// @Success 200 {object} dto.AuthTokensResponse
+// @Failure 401 {object} map[string]string "error: invalid refresh token"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /auth/refresh [post]
+func (a *AuthHandler) Refresh(c *fiber.Ctx) error {
+	return a.refresh(c, os.Getenv("COOKIE_DOMAIN"))
 }
 
+// @Summary Log out
+// @Description Revoke the current session and clear the auth cookies
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} map[string]string "message: Logout successful"
+// @Router /logout [post]
 func (a *AuthHandler) LogOut(c *fiber.Ctx) error {
-	// Delete JWT cookie
-	c.Cookie(&fiber.Cookie{
-		Name:     "authToken",
-		Value:    "",                             // empty value
-		Expires:  time.Now().Add(-1 * time.Hour), // set expiry in the past
-		HTTPOnly: true,
-		Secure:   os.Getenv("ENVIRONMENT") != "dev",
-		SameSite: fiber.CookieSameSiteNoneMode,
-		Path:     "/",                        // important: must match the path used when setting
-		Domain:   os.Getenv("COOKIE_DOMAIN"), // Domain for which the cookie is valid
-	})
-	// Optionally, redirect to a logout page or send a response
-	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "Logout successful"})
+	return a.logOut(c, os.Getenv("COOKIE_DOMAIN"))
+}
+
+// @Summary Log out of all devices
+// @Description Revoke every session of the current user
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} map[string]string "message: Logged out of all devices"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /logout-all [post]
+func (a *AuthHandler) LogOutAll(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := a.sessionService.RevokeAll(userID); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	clearAuthCookies(c, os.Getenv("COOKIE_DOMAIN"))
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "Logged out of all devices"})
+}
+
+// @Summary Verify email address
+// @Description Confirm the email address of an account with the token from the verification email
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body dto.VerifyEmailRequest true "Verification token"
+// @Success 200 {object} map[string]string "message: Email verified"
+// @Failure 400 {object} map[string]string "error: verification link is invalid or has expired"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /auth/verify-email [post]
+func (a *AuthHandler) VerifyEmail(c *fiber.Ctx) error {
+	var req dto.VerifyEmailRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	if err := a.authService.VerifyEmail(req.Token); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "Email verified"})
+}
+
+// @Summary Resend the verification email
+// @Description Send a new verification link. The response is the same whether or not the email is registered.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body dto.EmailRequest true "Email address"
+// @Success 200 {object} map[string]string "message: If the account exists, a verification email has been sent"
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /auth/verify-email/resend [post]
+func (a *AuthHandler) ResendVerificationEmail(c *fiber.Ctx) error {
+	var req dto.EmailRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	if err := a.authService.ResendVerificationEmail(req.Email); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "If the account exists, a verification email has been sent"})
+}
+
+// @Summary Request a password reset
+// @Description Mail a single-use password reset link. The response is the same whether or not the email is registered.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body dto.EmailRequest true "Email address"
+// @Success 200 {object} map[string]string "message: If the account exists, a reset email has been sent"
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /auth/forgot-password [post]
+func (a *AuthHandler) ForgotPassword(c *fiber.Ctx) error {
+	var req dto.EmailRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	if err := a.authService.ForgotPassword(req.Email); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "If the account exists, a reset email has been sent"})
+}
+
+// @Summary Reset password
+// @Description Set a new password with the token from the reset email. Every session of the account is logged out.
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body dto.ResetPasswordRequest true "Reset token and new password"
+// @Success 200 {object} map[string]string "message: Password has been reset"
+// @Failure 400 {object} map[string]string "error: reset link is invalid or has expired"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /auth/reset-password [post]
+func (a *AuthHandler) ResetPassword(c *fiber.Ctx) error {
+	var req dto.ResetPasswordRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	if err := a.authService.ResetPassword(req.Token, req.Password); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	clearAuthCookies(c, os.Getenv("COOKIE_DOMAIN"))
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "Password has been reset"})
 }
 
 // ----------------------------
@@ -125,40 +230,119 @@ func (a *AuthHandler) LogInAdmin(c *fiber.Ctx) error {
 	}
 
 	// Generate token
-	token, err := a.authService.LogIn(req.Email, req.Password)
+	tokens, err := a.authService.LogIn(req.Email, req.Password, sessionClientFromCtx(c))
 	if err != nil {
 		logs.Error(err.Error())
 		return errs.SendFiberError(c, err)
 	}
 
 	// Set the JWT token in a cookie after redirect
-	c.Cookie(&fiber.Cookie{
-		Name:     "authToken",
-		Value:    token,                              // Token from the auth service
-		Expires:  time.Now().Add(time.Hour * 24 * 7), // Set expiration for 7 days
-		HTTPOnly: true,                               // Prevent JavaScript access to the cookie
-		Secure:   os.Getenv("ENVIRONMENT") != "dev",  // Only send the cookie over HTTPS in production
-		SameSite: fiber.CookieSameSiteNoneMode,       // Allow cross-site cookie sharing
-		Path:     "/",                                // Path for which the cookie is valid
-		Domain:   os.Getenv("COOKIE_ADMIN_DOMAIN"),   // Domain for which the cookie is valid
-	})
+	setAuthCookies(c, tokens, os.Getenv("COOKIE_ADMIN_DOMAIN"))
 
 	// Send response and return nil to ensure proper handling
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "Login successful"})
 }
 
+func (a *AuthHandler) RefreshAdmin(c *fiber.Ctx) error {
+	return a.refresh(c, os.Getenv("COOKIE_ADMIN_DOMAIN"))
+}
+
 func (a *AuthHandler) LogOutAdmin(c *fiber.Ctx) error {
+	return a.logOut(c, os.Getenv("COOKIE_ADMIN_DOMAIN"))
+}
+
+func (a *AuthHandler) refresh(c *fiber.Ctx, cookieDomain string) error {
+	refreshToken := c.Cookies(refreshTokenCookie)
+	if len(c.Body()) > 0 {
+		var req dto.RefreshTokenRequest
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		if req.RefreshToken != "" {
+			refreshToken = req.RefreshToken
+		}
+	}
+	if refreshToken == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "Missing refresh token"})
+	}
+
+	tokens, err := a.sessionService.Refresh(refreshToken)
+	if err != nil {
+		clearAuthCookies(c, cookieDomain)
+		return errs.SendFiberError(c, err)
+	}
+
+	setAuthCookies(c, tokens, cookieDomain)
+	return c.Status(fiber.StatusOK).JSON(buildAuthTokensResponse("Token refreshed", tokens))
+}
+
+func (a *AuthHandler) logOut(c *fiber.Ctx, cookieDomain string) error {
+	// Revoke the session server-side so stolen copies of the tokens stop working too
+	if refreshToken := c.Cookies(refreshTokenCookie); refreshToken != "" {
+		if err := a.sessionService.RevokeByRefreshToken(refreshToken); err != nil {
+			return errs.SendFiberError(c, err)
+		}
+	}
+
 	// Delete JWT cookie
+	clearAuthCookies(c, cookieDomain)
+	// Optionally, redirect to a logout page or send a response
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "Logout successful"})
+}
+
+const (
+	authTokenCookie    = "authToken"
+	refreshTokenCookie = "refreshToken"
+)
+
+func sessionClientFromCtx(c *fiber.Ctx) service.SessionClient {
+	return service.SessionClient{UserAgent: c.Get(fiber.HeaderUserAgent), IPAddress: c.IP()}
+}
+
+func buildAuthTokensResponse(message string, tokens *service.AuthTokens) dto.AuthTokensResponse {
+	return dto.AuthTokensResponse{
+		Message:      message,
+		Token:        tokens.AccessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(time.Until(tokens.AccessExpiresAt).Seconds()),
+		RefreshToken: tokens.RefreshToken,
+	}
+}
+
+func setAuthCookies(c *fiber.Ctx, tokens *service.AuthTokens, domain string) {
+	c.Cookie(&fiber.Cookie{
+		Name:     authTokenCookie,
+		Value:    tokens.AccessToken,                // Token from the auth service
+		Expires:  tokens.AccessExpiresAt,            // Expires together with the access token
+		HTTPOnly: true,                              // Prevent JavaScript access to the cookie
+		Secure:   os.Getenv("ENVIRONMENT") != "dev", // Only send the cookie over HTTPS in production
+		SameSite: fiber.CookieSameSiteNoneMode,      // Allow cross-site cookie sharing
+		Path:     "/",                               // Path for which the cookie is valid
+		Domain:   domain,                            // Domain for which the cookie is valid
+	})
 	c.Cookie(&fiber.Cookie{
-		Name:     "authToken",
-		Value:    "",                             // empty value
-		Expires:  time.Now().Add(-1 * time.Hour), // set expiry in the past
+		Name:     refreshTokenCookie,
+		Value:    tokens.RefreshToken,
+		Expires:  tokens.RefreshExpiresAt,
 		HTTPOnly: true,
 		Secure:   os.Getenv("ENVIRONMENT") != "dev",
 		SameSite: fiber.CookieSameSiteNoneMode,
-		Path:     "/",                              // important: must match the path used when setting
-		Domain:   os.Getenv("COOKIE_ADMIN_DOMAIN"), // Domain for which the cookie is valid
+		Path:     "/",
+		Domain:   domain,
 	})
-	// Optionally, redirect to a logout page or send a response
-	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "Logout successful"})
+}
+
+func clearAuthCookies(c *fiber.Ctx, domain string) {
+	for _, name := range []string{authTokenCookie, refreshTokenCookie} {
+		c.Cookie(&fiber.Cookie{
+			Name:     name,
+			Value:    "",                             // empty value
+			Expires:  time.Now().Add(-1 * time.Hour), // set expiry in the past
+			HTTPOnly: true,
+			Secure:   os.Getenv("ENVIRONMENT") != "dev",
+			SameSite: fiber.CookieSameSiteNoneMode,
+			Path:     "/",    // important: must match the path used when setting
+			Domain:   domain, // Domain for which the cookie is valid
+		})
+	}
 }
@@ -0,0 +1,192 @@
+package handler
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/logs"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type BookmarkHandler struct {
+	service service.BookmarkService
+}
+
+func NewBookmarkHandler(service service.BookmarkService) *BookmarkHandler {
+	return &BookmarkHandler{service: service}
+}
+
+// @Summary List my bookmarks
+// @Description List the events, jobs and organizations the current user bookmarked, newest first
+// @Tags Bookmarks
+// @Produce json
+// @Param type query string false "Only list one type: event, job or organization"
+// @Success 200 {array} dto.BookmarkResponse
+// @Failure 400 {object} map[string]string "error: invalid bookmark type"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/bookmarks [get]
+func (h *BookmarkHandler) ListBookmarks(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	targetType := models.BookmarkTargetType(c.Query("type"))
+	if targetType != "" && !isBookmarkTargetType(targetType) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid bookmark type"})
+	}
+
+	bookmarks, err := h.service.ListBookmarks(userID, targetType)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(bookmarks)
+}
+
+// @Summary Bookmark an item
+// @Description Save an event, job or organization for later
+// @Tags Bookmarks
+// @Accept json
+// @Produce json
+// @Param body body dto.BookmarkRequest true "Item to bookmark"
+// @Success 201 {object} dto.BookmarkResponse
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 409 {object} map[string]string "error: this event is already bookmarked"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/bookmarks [post]
+func (h *BookmarkHandler) AddBookmark(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.BookmarkRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	bookmark, err := h.service.AddBookmark(userID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(bookmark)
+}
+
+// @Summary Remove a bookmark
+// @Description Remove an event, job or organization from the bookmarks of the current user
+// @Tags Bookmarks
+// @Produce json
+// @Param type path string true "Bookmark type: event, job or organization"
+// @Param targetID path int true "ID of the bookmarked item"
+// @Success 200 {object} map[string]string "message: bookmark removed successfully"
+// @Failure 400 {object} map[string]string "error: invalid bookmark type"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 404 {object} map[string]string "error: bookmark not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/bookmarks/{type}/{targetID} [delete]
+func (h *BookmarkHandler) RemoveBookmark(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	targetType := models.BookmarkTargetType(c.Params("type"))
+	if !isBookmarkTargetType(targetType) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid bookmark type"})
+	}
+
+	targetID, err := utils.GetParamFormFiberCtx(c, "targetID", string(targetType))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.service.RemoveBookmark(userID, targetType, targetID); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "bookmark removed successfully"})
+}
+
+func isBookmarkTargetType(targetType models.BookmarkTargetType) bool {
+	switch targetType {
+	case models.BookmarkTargetEvent, models.BookmarkTargetJob, models.BookmarkTargetOrganization:
+		return true
+	}
+	return false
+}
+
+// bookmarkedByCaller looks up which of ids the logged-in caller bookmarked. It reports false for
+// anonymous requests, and when the lookup fails, so listings still render without the flag.
+func bookmarkedByCaller(c *fiber.Ctx, bookmarks service.BookmarkService, targetType models.BookmarkTargetType, ids []uint) (map[uint]bool, bool) {
+	if bookmarks == nil || len(ids) == 0 {
+		return nil, false
+	}
+
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return nil, false
+	}
+
+	bookmarked, err := bookmarks.BookmarkedIDs(userID, targetType, ids)
+	if err != nil {
+		logs.Warn("Failed to look up bookmarks, returning the list without them: " + err.Error())
+		return nil, false
+	}
+
+	return bookmarked, true
+}
+
+func markBookmarkedEvents(c *fiber.Ctx, bookmarks service.BookmarkService, events []dto.EventDocumentDTOResponse) {
+	ids := make([]uint, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.ID)
+	}
+
+	bookmarked, ok := bookmarkedByCaller(c, bookmarks, models.BookmarkTargetEvent, ids)
+	if !ok {
+		return
+	}
+	for i := range events {
+		isBookmarked := bookmarked[events[i].ID]
+		events[i].IsBookmarked = &isBookmarked
+	}
+}
+
+func markBookmarkedJobs(c *fiber.Ctx, bookmarks service.BookmarkService, jobs []dto.JobDocumentDTOResponse) {
+	ids := make([]uint, 0, len(jobs))
+	for _, job := range jobs {
+		ids = append(ids, job.ID)
+	}
+
+	bookmarked, ok := bookmarkedByCaller(c, bookmarks, models.BookmarkTargetJob, ids)
+	if !ok {
+		return
+	}
+	for i := range jobs {
+		isBookmarked := bookmarked[jobs[i].ID]
+		jobs[i].IsBookmarked = &isBookmarked
+	}
+}
+
+func markBookmarkedJobDocuments(c *fiber.Ctx, bookmarks service.BookmarkService, jobs []dto.JobDocument) {
+	ids := make([]uint, 0, len(jobs))
+	for _, job := range jobs {
+		ids = append(ids, job.ID)
+	}
+
+	bookmarked, ok := bookmarkedByCaller(c, bookmarks, models.BookmarkTargetJob, ids)
+	if !ok {
+		return
+	}
+	for i := range jobs {
+		isBookmarked := bookmarked[jobs[i].ID]
+		jobs[i].IsBookmarked = &isBookmarked
+	}
+}
@@ -13,7 +13,8 @@ import (
 )
 
 type EventHandler struct {
-	eventService service.EventService
+	eventService    service.EventService
+	bookmarkService service.BookmarkService
 }
 
 type EventShortResponse struct {
@@ -51,9 +52,9 @@ func newListEventShortResponse(events []dto.EventResponses) []EventShortResponse
 	return listEvent
 }
 
-// NewEventHandler creates a new eventHandler
-func NewEventHandler(eventService service.EventService) EventHandler {
-	return EventHandler{eventService: eventService}
+// NewEventHandler creates a new eventHandler. bookmarkService may be nil, then listings never carry isBookmarked.
+func NewEventHandler(eventService service.EventService, bookmarkService service.BookmarkService) EventHandler {
+	return EventHandler{eventService: eventService, bookmarkService: bookmarkService}
 }
 
 // @Summary Create a new event
@@ -196,6 +197,63 @@ func (h EventHandler) GetEventByIDwithOrgID(c *fiber.Ctx) error {
 	return c.JSON(event)
 }
 
+// @Summary List all events an organization manages
+// @Description Get all the events of an organization, including those hidden by moderation or of a suspended organization
+// @Tags Organization Events
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Success 200 {array} []dto.EventResponses
+// @Failure 400 {object} map[string]string "error: Invalid parameters"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events [get]
+func (h EventHandler) ListManagedEventsByOrgID(c *fiber.Ctx) error {
+	orgID, err := c.ParamsInt("orgID")
+
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "organization id is required"})
+	}
+
+	events, err := h.eventService.GetAllManagedEventsByOrgID(uint(orgID))
+
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.JSON(events)
+}
+
+// @Summary Get an event an organization manages
+// @Description Get an event of an organization by its ID, even when hidden by moderation or of a suspended organization
+// @Tags Organization Events
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Success 200 {object} dto.EventResponses
+// @Failure 400 {object} map[string]string "error: Invalid parameters"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id} [get]
+func (h EventHandler) GetManagedEventByID(c *fiber.Ctx) error {
+	orgID, err := c.ParamsInt("orgID")
+
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "organization id is required"})
+	}
+
+	eventID, err := c.ParamsInt("id")
+
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "event id is required"})
+	}
+
+	event, err := h.eventService.GetManagedEventByID(uint(orgID), uint(eventID))
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.JSON(event)
+}
+
 // @Summary List all categories
 // @Description Get a list of all event categories
 // @Tags Events
@@ -231,6 +289,7 @@ func (h EventHandler) EventPaginate(c *fiber.Ctx) error {
 	if err != nil {
 		return errs.SendFiberError(c, err)
 	}
+	markBookmarkedEvents(c, h.bookmarkService, events)
 
 	//total, err := h.eventService.CountEvent()
 	//if err != nil {
@@ -383,6 +442,7 @@ func (h EventHandler) SearchEvents(c *fiber.Ctx) error {
 	if err != nil {
 		return errs.SendFiberError(c, err)
 	}
+	markBookmarkedEvents(c, h.bookmarkService, events.Events)
 
 	return c.Status(fiber.StatusOK).JSON(events)
 }
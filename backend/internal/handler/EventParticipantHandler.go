@@ -0,0 +1,255 @@
+package handler
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strconv"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/logs"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type EventParticipantHandler struct {
+	service models.EventParticipantService
+}
+
+func NewEventParticipantHandler(service models.EventParticipantService) *EventParticipantHandler {
+	return &EventParticipantHandler{service: service}
+}
+
+// @Summary Register for an event
+// @Description Register the current user for a free event. Attendees are visible on the public list unless isVisible is false.
+// @Tags Event Participants
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param body body dto.RegisterEventRequest false "Visibility on the public attendee list"
+// @Success 201 {object} dto.EventRegistrationResponse
+// @Failure 400 {object} map[string]string "error: this event requires a ticket purchase"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 409 {object} map[string]string "error: already registered / event has reached its capacity"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /events/{id}/register [post]
+func (h *EventParticipantHandler) Register(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	isVisible := true
+	if len(c.Body()) > 0 {
+		var req dto.RegisterEventRequest
+		if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+			return err
+		}
+		if req.IsVisible != nil {
+			isVisible = *req.IsVisible
+		}
+	}
+
+	participant, err := h.service.Register(userID, eventID, isVisible)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(dto.BuildEventRegistrationResponse(*participant))
+}
+
+// @Summary Unregister from an event
+// @Description Cancel the current user's registration for an event
+// @Tags Event Participants
+// @Produce json
+// @Param id path int true "Event ID"
+// @Success 200 {object} map[string]string "message: unregistered successfully"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 404 {object} map[string]string "error: registration not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /events/{id}/register [delete]
+func (h *EventParticipantHandler) Unregister(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.service.Unregister(userID, eventID); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "unregistered successfully"})
+}
+
+// @Summary Get my registration for an event
+// @Description Get the current user's registration for an event
+// @Tags Event Participants
+// @Produce json
+// @Param id path int true "Event ID"
+// @Success 200 {object} dto.EventRegistrationResponse
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 404 {object} map[string]string "error: registration not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /events/{id}/register [get]
+func (h *EventParticipantHandler) GetMyRegistration(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	participant, err := h.service.GetMyRegistration(userID, eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.BuildEventRegistrationResponse(*participant))
+}
+
+// @Summary Show or hide myself on the attendee list
+// @Description Toggle whether the current user appears on the public attendee list of an event
+// @Tags Event Participants
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param body body dto.UpdateParticipantVisibilityRequest true "Visibility"
+// @Success 200 {object} map[string]string "message: visibility updated successfully"
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 404 {object} map[string]string "error: registration not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /events/{id}/register/visibility [patch]
+func (h *EventParticipantHandler) UpdateVisibility(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.UpdateParticipantVisibilityRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	if err := h.service.UpdateVisibility(userID, eventID, *req.IsVisible); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "visibility updated successfully"})
+}
+
+// @Summary List public attendees of an event
+// @Description List attendees who chose to be visible, along with the total number of registrations
+// @Tags Event Participants
+// @Produce json
+// @Param id path int true "Event ID"
+// @Success 200 {object} dto.PublicParticipantListResponse
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /events/{id}/participants [get]
+func (h *EventParticipantHandler) ListPublicParticipants(c *fiber.Ctx) error {
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	participants, total, err := h.service.ListPublicParticipants(eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.BuildPublicParticipantListResponse(participants, total))
+}
+
+// @Summary List registrants of an event
+// @Description List every registrant of an event of the organization, including hidden ones
+// @Tags Event Participants
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Success 200 {object} dto.ParticipantListResponse
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/participants [get]
+func (h *EventParticipantHandler) ListParticipants(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	participants, err := h.service.ListParticipants(orgID, eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.BuildParticipantListResponse(participants))
+}
+
+// @Summary Export registrants of an event as CSV
+// @Description Download every registrant of an event of the organization as a CSV file
+// @Tags Event Participants
+// @Produce text/csv
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Success 200 {file} file "participants.csv"
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/participants/export [get]
+func (h *EventParticipantHandler) ExportParticipantsCSV(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	participants, err := h.service.ListParticipants(orgID, eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+	c.Attachment(fmt.Sprintf("event-%d-participants.csv", eventID))
+
+	writer := csv.NewWriter(c)
+	rows := [][]string{{"user_id", "name", "email", "is_visible", "registered_at"}}
+	for _, participant := range participants {
+		rows = append(rows, []string{
+			participant.User.ID.String(),
+			participant.User.Name,
+			participant.User.Email,
+			strconv.FormatBool(participant.IsVisible),
+			participant.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+	if err := writer.WriteAll(rows); err != nil {
+		logs.Error(err)
+		return errs.SendFiberError(c, errs.NewUnexpectedError())
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type ExperienceHandler struct {
+	service models.ExperienceService
+}
+
+func NewExperienceHandler(service models.ExperienceService) *ExperienceHandler {
+	return &ExperienceHandler{service: service}
+}
+
+// @Summary List my experiences
+// @Description List the work history of the current user in chronological order
+// @Tags Experiences
+// @Produce json
+// @Success 200 {array} dto.ExperienceResponse
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/experiences [get]
+func (h *ExperienceHandler) ListExperiences(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	experiences, err := h.service.ListExperiencesByUserID(userID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.BuildExperienceResponses(experiences))
+}
+
+// @Summary Get one of my experiences
+// @Description Get an experience of the current user by its ID
+// @Tags Experiences
+// @Produce json
+// @Param id path string true "Experience ID"
+// @Success 200 {object} dto.ExperienceResponse
+// @Failure 400 {object} map[string]string "error: invalid experience id"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 404 {object} map[string]string "error: experience not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/experiences/{id} [get]
+func (h *ExperienceHandler) GetExperience(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	experienceID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid experience id"})
+	}
+
+	experience, err := h.service.GetExperienceByID(userID, experienceID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.BuildExperienceResponse(*experience))
+}
+
+// @Summary Add an experience
+// @Description Add an entry to the work history of the current user. Dates use the YYYY-MM-DD format; endDate is omitted while currently is true.
+// @Tags Experiences
+// @Accept json
+// @Produce json
+// @Param body body dto.ExperienceRequest true "Experience"
+// @Success 201 {object} dto.ExperienceResponse
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/experiences [post]
+func (h *ExperienceHandler) CreateExperience(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.ExperienceRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	experience, err := experienceFromRequest(req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	if err := h.service.CreateExperience(userID, experience); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(dto.BuildExperienceResponse(*experience))
+}
+
+// @Summary Update an experience
+// @Description Replace an entry in the work history of the current user
+// @Tags Experiences
+// @Accept json
+// @Produce json
+// @Param id path string true "Experience ID"
+// @Param body body dto.ExperienceRequest true "Experience"
+// @Success 200 {object} dto.ExperienceResponse
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 404 {object} map[string]string "error: experience not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/experiences/{id} [put]
+func (h *ExperienceHandler) UpdateExperience(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	experienceID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid experience id"})
+	}
+
+	var req dto.ExperienceRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	experience, err := experienceFromRequest(req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+	experience.UUID = experienceID
+
+	if err := h.service.UpdateExperience(userID, experience); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.BuildExperienceResponse(*experience))
+}
+
+// @Summary Delete an experience
+// @Description Remove an entry from the work history of the current user
+// @Tags Experiences
+// @Produce json
+// @Param id path string true "Experience ID"
+// @Success 200 {object} map[string]string "message: experience deleted successfully"
+// @Failure 400 {object} map[string]string "error: invalid experience id"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 404 {object} map[string]string "error: experience not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/experiences/{id} [delete]
+func (h *ExperienceHandler) DeleteExperience(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	experienceID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid experience id"})
+	}
+
+	if err := h.service.DeleteExperience(userID, experienceID); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "experience deleted successfully"})
+}
+
+func experienceFromRequest(req dto.ExperienceRequest) (*models.Experience, error) {
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		return nil, errs.NewBadRequestError("startDate must be in YYYY-MM-DD format")
+	}
+
+	experience := &models.Experience{
+		Title:       req.Title,
+		Description: req.Description,
+		PicUrl:      req.PicUrl,
+		StartDate:   startDate,
+		Currently:   req.Currently,
+	}
+
+	if req.EndDate != "" {
+		endDate, err := time.Parse("2006-01-02", req.EndDate)
+		if err != nil {
+			return nil, errs.NewBadRequestError("endDate must be in YYYY-MM-DD format")
+		}
+		experience.EndDate = &endDate
+	}
+
+	return experience, nil
+}
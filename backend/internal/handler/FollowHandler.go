@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	defaultFeedLimit = 20
+	maxFeedLimit     = 50
+)
+
+type FollowHandler struct {
+	service service.FollowService
+}
+
+func NewFollowHandler(service service.FollowService) *FollowHandler {
+	return &FollowHandler{service: service}
+}
+
+// @Summary Follow an organization
+// @Description Follow an organization to see the events and jobs it publishes in your feed
+// @Tags Follows
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Success 200 {object} dto.FollowResponse
+// @Failure 400 {object} map[string]string "error: invalid organization id"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 404 {object} map[string]string "error: organization not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /orgs/{orgID}/follow [post]
+func (h *FollowHandler) Follow(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	orgID, err := utils.GetParamFormFiberCtx(c, "orgID", "organization")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	follow, err := h.service.Follow(userID, orgID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(follow)
+}
+
+// @Summary Unfollow an organization
+// @Description Stop following an organization
+// @Tags Follows
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Success 200 {object} dto.FollowResponse
+// @Failure 400 {object} map[string]string "error: invalid organization id"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 404 {object} map[string]string "error: you are not following this organization"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /orgs/{orgID}/follow [delete]
+func (h *FollowHandler) Unfollow(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	orgID, err := utils.GetParamFormFiberCtx(c, "orgID", "organization")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	follow, err := h.service.Unfollow(userID, orgID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(follow)
+}
+
+// @Summary List the organizations I follow
+// @Description List the organizations the current user follows, most recently followed first
+// @Tags Follows
+// @Produce json
+// @Success 200 {array} dto.OrganizationShortDocument
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/following [get]
+func (h *FollowHandler) ListFollowing(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	orgs, err := h.service.ListFollowing(userID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(orgs)
+}
+
+// @Summary Get my activity feed
+// @Description Events and jobs published by the organizations the current user follows, newest first.
+// @Description Pass the nextCursor of a response as the cursor query to get the next page.
+// @Tags Follows
+// @Produce json
+// @Param cursor query string false "Cursor from the previous page"
+// @Param limit query int false "Items per page, 20 by default and at most 50"
+// @Success 200 {object} dto.FeedResponse
+// @Failure 400 {object} map[string]string "error: invalid cursor"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/feed [get]
+func (h *FollowHandler) GetFeed(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	limit := c.QueryInt("limit", defaultFeedLimit)
+	if limit < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid limit"})
+	}
+	if limit > maxFeedLimit {
+		limit = maxFeedLimit
+	}
+
+	feed, err := h.service.GetFeed(userID, c.Query("cursor"), limit)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(feed)
+}
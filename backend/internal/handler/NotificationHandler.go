@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type NotificationHandler struct {
+	service service.NotificationService
+}
+
+func NewNotificationHandler(service service.NotificationService) *NotificationHandler {
+	return &NotificationHandler{service: service}
+}
+
+// @Summary List my notifications
+// @Description List the in-app notifications of the current user, newest first, 20 per page
+// @Tags Notifications
+// @Produce json
+// @Param page query int false "Page number"
+// @Param unread query bool false "Only list unread notifications"
+// @Success 200 {object} dto.NotificationListResponse
+// @Failure 400 {object} map[string]string "error: invalid page"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/notifications [get]
+func (h *NotificationHandler) ListNotifications(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	page := c.QueryInt("page", 1)
+	if page < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid page"})
+	}
+
+	notifications, err := h.service.ListNotifications(userID, c.QueryBool("unread", false), page)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(notifications)
+}
+
+// @Summary Count my unread notifications
+// @Description Get how many notifications of the current user are unread
+// @Tags Notifications
+// @Produce json
+// @Success 200 {object} dto.UnreadCountResponse
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/notifications/unread-count [get]
+func (h *NotificationHandler) GetUnreadCount(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	count, err := h.service.GetUnreadCount(userID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(count)
+}
+
+// @Summary Mark a notification as read
+// @Tags Notifications
+// @Produce json
+// @Param id path int true "Notification ID"
+// @Success 200 {object} map[string]string "message: notification marked as read"
+// @Failure 400 {object} map[string]string "error: invalid notification id"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 404 {object} map[string]string "error: notification not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/notifications/{id}/read [patch]
+func (h *NotificationHandler) MarkRead(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	id, err := utils.GetParamFormFiberCtx(c, "id", "notification")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.service.MarkRead(userID, id); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "notification marked as read"})
+}
+
+// @Summary Mark all my notifications as read
+// @Tags Notifications
+// @Produce json
+// @Success 200 {object} map[string]string "message: all notifications marked as read"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/notifications/read-all [patch]
+func (h *NotificationHandler) MarkAllRead(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.service.MarkAllRead(userID); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "all notifications marked as read"})
+}
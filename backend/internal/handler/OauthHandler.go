@@ -1,16 +1,12 @@
 package handler
 
 import (
-	"context"
-	"encoding/json"
 	"fmt"
-	"os"
-	"time"
 
 	"github.com/DAF-Bridge/asaiasa-Backend/errs"
 
-	"github.com/DAF-Bridge/asaiasa-Backend/initializers"
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/pkg/oauthprovider"
 
 	"github.com/DAF-Bridge/asaiasa-Backend/logs"
 	"github.com/golang-jwt/jwt/v5"
@@ -22,11 +18,13 @@ import (
 )
 
 type OauthHandler struct {
-	oauthService *service.OauthService
+	oauthService   *service.OauthService
+	providers      oauthprovider.Registry
+	adminProviders oauthprovider.Registry
 }
 
-func NewOauthHandler(oauthService *service.OauthService) *OauthHandler {
-	return &OauthHandler{oauthService: oauthService}
+func NewOauthHandler(oauthService *service.OauthService, providers oauthprovider.Registry, adminProviders oauthprovider.Registry) *OauthHandler {
+	return &OauthHandler{oauthService: oauthService, providers: providers, adminProviders: adminProviders}
 }
 
 // GoogleLogin starts the Google OAuth process
@@ -49,18 +47,33 @@ func NewOauthHandler(oauthService *service.OauthService) *OauthHandler {
 // 	return c.Redirect(url, fiber.StatusTemporaryRedirect)
 // }
 
-// GoogleCallback handles the callback from Google
-func (h *OauthHandler) GoogleCallback(c *fiber.Ctx) error {
+// Callback completes a login with any enabled provider (/auth/:provider/callback)
+func (h *OauthHandler) Callback(c *fiber.Ctx) error {
+	return h.callback(c, h.providers)
+}
+
+// AdminCallback is Callback for the admin site, whose redirect URLs differ
+func (h *OauthHandler) AdminCallback(c *fiber.Ctx) error {
+	return h.callback(c, h.adminProviders)
+}
+
+func (h *OauthHandler) callback(c *fiber.Ctx, providers oauthprovider.Registry) error {
+	provider, ok := providers.Get(c.Params("provider"))
+	if !ok {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"success": false,
+			"message": fmt.Sprintf("Unsupported login provider: %s", c.Params("provider")),
+		})
+	}
+
 	// Get and validate required parameters
 	code := c.Query("code")
 	// state := c.Query("state")
 	errorParam := c.Query("error")
 
-	// Debug logging removed
-
-	// Check for OAuth errors from Google
+	// Check for OAuth errors from the provider
 	if errorParam != "" {
-		logs.Error(fmt.Sprintf("OAuth error from Google: %s", errorParam))
+		logs.Error(fmt.Sprintf("OAuth error from %s: %s", provider.Name, errorParam))
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"success": false,
 			"message": fmt.Sprintf("OAuth error: %s", errorParam),
@@ -75,145 +88,29 @@ func (h *OauthHandler) GoogleCallback(c *fiber.Ctx) error {
 		})
 	}
 
-	// Exchange the authorization code for an access token
-	token, err := initializers.OauthConfig.Exchange(context.Background(), code)
-	if err != nil {
-		logs.Error(fmt.Sprintf("Failed to exchange token: %v", err))
-		logs.Error(fmt.Sprintf("OAuth Config RedirectURL during exchange: %s", initializers.OauthConfig.RedirectURL))
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"success": false,
-			"message": fmt.Sprintf("Failed to exchange token: %v", err),
-		})
-	}
-	// Use the token to fetch user info
-	client := initializers.OauthConfig.Client(c.Context(), token)
-	resp, err := client.Get("https://www.googleapis.com/oauth2/v2/userinfo")
-	if err != nil {
-		logs.Error(fmt.Sprintf("Failed to fetch user info: %v", err))
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"success": false,
-			"message": "Failed to fetch user info: " + err.Error(),
-		})
-	}
-	defer resp.Body.Close()
-
-	// Parse user info (replace with actual user struct as needed)
-	var userInfo struct {
-		Name      string `json:"name"`
-		Email     string `json:"email"`
-		Provider  string `json:"provider"`
-		UserID    string `json:"id"`
-		AvatarURL string `json:"picture"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&userInfo); err != nil {
-		logs.Error(fmt.Sprintf("Failed to parse user info: %v", err))
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"success": false,
-			"message": "Failed to parse user info: " + err.Error(),
-		})
-	}
-	// create or update a user record in your DB and Generate token
-	tokenString, err := h.oauthService.AuthenticateUser(
-		userInfo.Name,
-		userInfo.Email,
-		"google",
-		userInfo.UserID,
-	)
-	if err != nil {
-		logs.Error(fmt.Sprintf("Failed to authenticate user: %v", err))
-		return errs.SendFiberError(c, err)
-	}
-
-	// Set the JWT token in a cookie after redirect
-	c.Cookie(&fiber.Cookie{
-		Name:     "authToken",
-		Value:    tokenString,                              // Token from the auth service
-		Expires:  time.Now().Add(time.Hour * 24 * 7),       // Set expiration for 7 days
-		HTTPOnly: true,                                     // Prevent JavaScript access to the cookie
-		Secure:   os.Getenv("ENVIRONMENT") == "production", // Only send the cookie over HTTPS in production
-		SameSite: "None",
-		Path:     "/", // Path for which the cookie is valid
-	})
-
-	// Return token for Bearer authentication
-	return c.Status(fiber.StatusOK).JSON(fiber.Map{
-		"message":    "OAuth login successful",
-		"token":      tokenString,
-		"token_type": "Bearer",
-	})
-}
-
-func (h *OauthHandler) AdminGoogleCallback(c *fiber.Ctx) error {
-	// Get and validate required parameters
-	code := c.Query("code")
-
-	// Exchange the authorization code for an access token
-	token, err := initializers.OauthConfigAdmin.Exchange(context.Background(), code)
-	if err != nil {
-		logs.Error(fmt.Sprintf("Failed to exchange token: %v", err))
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"success": false,
-			"message": fmt.Sprintf("Failed to exchange token: %v", err),
-		})
-	}
-
-	// Use the token to fetch user info
-	client := initializers.OauthConfigAdmin.Client(c.Context(), token)
-	resp, err := client.Get("https://www.googleapis.com/oauth2/v2/userinfo")
+	// Exchange the authorization code and fetch the user profile
+	userInfo, err := provider.FetchUserInfo(c.Context(), code)
 	if err != nil {
-		logs.Error(fmt.Sprintf("Failed to fetch user info: %v", err))
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"success": false,
-			"message": "Failed to fetch user info: " + err.Error(),
-		})
-	}
-	defer resp.Body.Close()
-
-	// Parse user info (replace with actual user struct as needed)
-	var userInfo struct {
-		Name      string `json:"name"`
-		Email     string `json:"email"`
-		Provider  string `json:"provider"`
-		UserID    string `json:"id"`
-		AvatarURL string `json:"picture"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&userInfo); err != nil {
-		logs.Error(fmt.Sprintf("Failed to parse user info: %v", err))
+		logs.Error(err.Error())
+		logs.Error(fmt.Sprintf("OAuth Config RedirectURL during exchange: %s", provider.Config.RedirectURL))
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"success": false,
-			"message": "Failed to parse user info: " + err.Error(),
+			"message": err.Error(),
 		})
 	}
 
 	// create or update a user record in your DB and Generate token
-	tokenString, err := h.oauthService.AuthenticateUser(
-		userInfo.Name,
-		userInfo.Email,
-		"google",
-		userInfo.UserID,
-	)
+	tokens, err := h.oauthService.AuthenticateUser(*userInfo, sessionClientFromCtx(c))
 	if err != nil {
 		logs.Error(fmt.Sprintf("Failed to authenticate user: %v", err))
 		return errs.SendFiberError(c, err)
 	}
 
 	// Set the JWT token in a cookie after redirect
-	c.Cookie(&fiber.Cookie{
-		Name:     "authToken",
-		Value:    tokenString,                              // Token from the auth service
-		Expires:  time.Now().Add(time.Hour * 24 * 7),       // Set expiration for 7 days
-		HTTPOnly: true,                                     // Prevent JavaScript access to the cookie
-		Secure:   os.Getenv("ENVIRONMENT") == "production", // Only send the cookie over HTTPS in production
-		SameSite: "None",
-		Path:     "/", // Path for which the cookie is valid
-	})
+	setAuthCookies(c, tokens, "")
 
 	// Return token for Bearer authentication
-	return c.Status(fiber.StatusOK).JSON(fiber.Map{
-		"message":    "OAuth login successful",
-		"token":      tokenString,
-		"token_type": "Bearer",
-	})
+	return c.Status(fiber.StatusOK).JSON(buildAuthTokensResponse("OAuth login successful", tokens))
 }
 
 //  old version
@@ -107,7 +107,7 @@ func (h *OrganizationHandler) ListIndustries(c *fiber.Ctx) error {
 }
 
 // @Summary Get an organization by ID
-// @Description Get an organization by ID
+// @Description Get an approved organization by ID
 // @Tags Organization
 // @Accept json
 // @Produce json
@@ -117,6 +117,34 @@ func (h *OrganizationHandler) ListIndustries(c *fiber.Ctx) error {
 // @Failure 404 {object} map[string]string "error: organization not found"
 // @Failure 500 {object} map[string]string "error: Internal Server Error"
 // @Router /orgs/get/{id} [get]
+func (h *OrganizationHandler) GetPublicOrganizationByID(c *fiber.Ctx) error {
+	orgID, err := c.ParamsInt("orgID")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "organization id is required"})
+	}
+	if orgID < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	org, err := h.service.GetPublicOrganizationByID(uint(orgID))
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(org)
+}
+
+// @Summary Get an organization an admin manages
+// @Description Get an organization by ID, whatever its review status
+// @Tags Organization
+// @Accept json
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Success 200 {object} dto.OrganizationResponse
+// @Failure 400 {object} map[string]string "error: organization id is required"
+// @Failure 404 {object} map[string]string "error: organization not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/get/{orgID} [get]
 func (h *OrganizationHandler) GetOrganizationByID(c *fiber.Ctx) error {
 
 	orgID, err := c.ParamsInt("orgID")
@@ -160,6 +188,58 @@ func (h *OrganizationHandler) GetOrganizationPaginate(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(organizations)
 }
 
+// SearchOrganizations handles the search for organizations based on the provided query parameters.
+// @Summary Search for organizations
+// @Description Search approved organizations by keyword with optional industry, province and country filters.
+// @Tags Organization
+// @Accept json
+// @Produce json
+// @Param q query string false "Keyword to search for organizations (Support: name, headline, description, industries, province, country)"
+// @Param industry query string false "Comma separated industries to filter by"
+// @Param province query string false "Province of the organization"
+// @Param country query string false "Country of the organization"
+// @Param page query int false "Page number for pagination" default(1)
+// @Param offset query int false "Number of items per page" default(12)
+// @Success 200 {object} dto.SearchOrganizationResponse
+// @Failure 400 {object} map[string]string "error: Bad Request - invalid query parameters"
+// @Failure 404 {object} map[string]string "error: No search results found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /orgs-paginate/search [get]
+func (h *OrganizationHandler) SearchOrganizations(c *fiber.Ctx) error {
+	page := 1
+	Offset := 12
+
+	var query dto.SearchOrganizationQuery
+
+	if err := c.QueryParser(&query); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid query parameters",
+		})
+	}
+	if query.Page > 0 {
+		page = query.Page
+	}
+	if query.Offset > 0 {
+		Offset = query.Offset
+	}
+
+	orgs, err := h.service.SearchOrganizations(query, page, Offset)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(orgs)
+}
+
+func (h *OrganizationHandler) SyncOrganizations(c *fiber.Ctx) error {
+	err := h.service.SyncOrganizations()
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return nil
+}
+
 // @Summary Update an organization by ID
 // @Description Update an organization by ID
 // @Tags Organization
@@ -438,12 +518,13 @@ func (h *OrganizationContactHandler) DeleteContact(c *fiber.Ctx) error {
 // --------------------------------------------------------------------------
 
 type OrgOpenJobHandler struct {
-	service service.OrgOpenJobService
+	service         service.OrgOpenJobService
+	bookmarkService service.BookmarkService
 }
 
-// Constructor
-func NewOrgOpenJobHandler(service service.OrgOpenJobService) *OrgOpenJobHandler {
-	return &OrgOpenJobHandler{service: service}
+// Constructor. bookmarkService may be nil, then listings never carry isBookmarked.
+func NewOrgOpenJobHandler(service service.OrgOpenJobService, bookmarkService service.BookmarkService) *OrgOpenJobHandler {
+	return &OrgOpenJobHandler{service: service, bookmarkService: bookmarkService}
 }
 
 // @Summary Create a new organization open job
@@ -570,6 +651,64 @@ func (h *OrgOpenJobHandler) GetOrgOpenJobByIDwithOrgID(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(org)
 }
 
+// @Summary List all jobs an organization manages
+// @Description Get all the open jobs of an organization, including those hidden by moderation or of a suspended organization
+// @Tags Organization Job
+// @Accept json
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Success 200 {array} dto.JobResponses
+// @Failure 400 {object} map[string]string "error: Bad Request - organization id is required"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/jobs/list [get]
+func (h *OrgOpenJobHandler) ListManagedJobsByOrgID(c *fiber.Ctx) error {
+	orgID, err := c.ParamsInt("orgID")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "organization id is required"})
+	}
+	if orgID < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid organization id"})
+	}
+
+	jobs, err := h.service.GetAllManagedJobsByOrgID(uint(orgID))
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(jobs)
+}
+
+// @Summary Get an open job an organization manages
+// @Description Get an organization open job by ID, even when hidden by moderation or of a suspended organization
+// @Tags Organization Job
+// @Accept json
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Job ID"
+// @Success 200 {object} dto.JobResponses
+// @Failure 400 {object} map[string]string "error: Bad Request - organization id & job id is required"
+// @Failure 404 {object} map[string]string "error: job not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/jobs/get/{id} [get]
+func (h *OrgOpenJobHandler) GetManagedJobByID(c *fiber.Ctx) error {
+	orgID, err := c.ParamsInt("orgID")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "organization id is required"})
+	}
+
+	jobID, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "job id is required"})
+	}
+
+	job, err := h.service.GetManagedJobByID(uint(orgID), uint(jobID))
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(job)
+}
+
 // @Summary Update an organization open job by ID
 // @Description Update an organization open job by ID
 // @Tags Organization Job
@@ -666,6 +805,7 @@ func (h *OrgOpenJobHandler) GetPaginateOrgOpenJob(c *fiber.Ctx) error {
 	if err != nil {
 		return errs.SendFiberError(c, err)
 	}
+	markBookmarkedJobs(c, h.bookmarkService, jobs)
 
 	return c.Status(fiber.StatusOK).JSON(jobs)
 }
@@ -713,6 +853,7 @@ func (h *OrgOpenJobHandler) SearchJobs(c *fiber.Ctx) error {
 	if err != nil {
 		return errs.SendFiberError(c, err)
 	}
+	markBookmarkedJobDocuments(c, h.bookmarkService, events.Jobs)
 
 	return c.Status(fiber.StatusOK).JSON(events)
 }
@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type OrganizationReviewHandler struct {
+	service service.OrganizationReviewService
+}
+
+func NewOrganizationReviewHandler(service service.OrganizationReviewService) *OrganizationReviewHandler {
+	return &OrganizationReviewHandler{service: service}
+}
+
+// @Summary List organizations by review status
+// @Description List organizations in a review status for system admins, the longest waiting first, 20 per page
+// @Tags Organization Reviews
+// @Produce json
+// @Param status query string false "pending (default), approved, rejected, changes_requested or suspended"
+// @Param page query int false "Page number"
+// @Success 200 {array} dto.OrganizationResponse
+// @Failure 400 {object} map[string]string "error: invalid organization status"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /sysadmin/orgs [get]
+func (h *OrganizationReviewHandler) ListOrganizations(c *fiber.Ctx) error {
+	page := c.QueryInt("page", 1)
+	if page < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid page"})
+	}
+
+	orgs, err := h.service.ListOrganizations(c.Query("status", models.OrgStatusPending), uint(page))
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(orgs)
+}
+
+// @Summary Approve an organization
+// @Description Approve a pending organization so it is listed publicly, and notify its owners
+// @Tags Organization Reviews
+// @Accept json
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Param body body dto.OrganizationReviewRequest false "Optional note for the owners"
+// @Success 200 {object} dto.OrganizationReviewResponse
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: organization not found"
+// @Failure 409 {object} map[string]string "error: organization is not waiting for review"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /sysadmin/orgs/{orgID}/approve [post]
+func (h *OrganizationReviewHandler) Approve(c *fiber.Ctx) error {
+	return h.review(c, models.OrgStatusApproved)
+}
+
+// @Summary Reject an organization
+// @Description Reject a pending organization with a reason, and notify its owners
+// @Tags Organization Reviews
+// @Accept json
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Param body body dto.OrganizationReviewRequest true "Reason shown to the owners"
+// @Success 200 {object} dto.OrganizationReviewResponse
+// @Failure 400 {object} map[string]string "error: a reason is required to reject or request changes"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: organization not found"
+// @Failure 409 {object} map[string]string "error: organization is not waiting for review"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /sysadmin/orgs/{orgID}/reject [post]
+func (h *OrganizationReviewHandler) Reject(c *fiber.Ctx) error {
+	return h.review(c, models.OrgStatusRejected)
+}
+
+// @Summary Request changes to an organization
+// @Description Send a pending organization back to its owners with the changes needed. They resubmit it by setting its status to pending.
+// @Tags Organization Reviews
+// @Accept json
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Param body body dto.OrganizationReviewRequest true "Changes needed"
+// @Success 200 {object} dto.OrganizationReviewResponse
+// @Failure 400 {object} map[string]string "error: a reason is required to reject or request changes"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: organization not found"
+// @Failure 409 {object} map[string]string "error: organization is not waiting for review"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /sysadmin/orgs/{orgID}/request-changes [post]
+func (h *OrganizationReviewHandler) RequestChanges(c *fiber.Ctx) error {
+	return h.review(c, models.OrgStatusChangesRequested)
+}
+
+// @Summary List the reviews of an organization
+// @Description List the review decisions on an organization, newest first
+// @Tags Organization Reviews
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Success 200 {array} dto.OrganizationReviewResponse
+// @Failure 400 {object} map[string]string "error: invalid organization id"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/reviews [get]
+func (h *OrganizationReviewHandler) ListReviews(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	reviews, err := h.service.ListReviews(orgID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(reviews)
+}
+
+func (h *OrganizationReviewHandler) review(c *fiber.Ctx, decision string) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.OrganizationReviewRequest
+	if len(c.Body()) > 0 {
+		if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+			return err
+		}
+	}
+
+	review, err := h.service.Review(userID, orgID, decision, req.Reason)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(review)
+}
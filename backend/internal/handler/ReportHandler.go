@@ -0,0 +1,215 @@
+package handler
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type ReportHandler struct {
+	service service.ReportService
+}
+
+func NewReportHandler(service service.ReportService) *ReportHandler {
+	return &ReportHandler{service: service}
+}
+
+// @Summary Report content
+// @Description Report an event, job, organization or user profile to the system admins. targetId is the numeric ID, or the UUID of a user.
+// @Tags Reports
+// @Accept json
+// @Produce json
+// @Param body body dto.ReportRequest true "What is reported and why"
+// @Success 201 {object} dto.ReportResponse
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 409 {object} map[string]string "error: you have already reported this event"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /reports [post]
+func (h *ReportHandler) CreateReport(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.ReportRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	report, err := h.service.CreateReport(userID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(report)
+}
+
+type ModerationHandler struct {
+	service service.ModerationService
+}
+
+func NewModerationHandler(service service.ModerationService) *ModerationHandler {
+	return &ModerationHandler{service: service}
+}
+
+// @Summary List reports
+// @Description List reports in a status for system admins, the longest waiting first, 20 per page
+// @Tags Moderation
+// @Produce json
+// @Param status query string false "open (default), dismissed or actioned"
+// @Param targetType query string false "event, job, organization or user"
+// @Param page query int false "Page number"
+// @Success 200 {object} dto.PaginatedReportsResponse
+// @Failure 400 {object} map[string]string "error: invalid report status"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /sysadmin/reports [get]
+func (h *ModerationHandler) ListReports(c *fiber.Ctx) error {
+	page := c.QueryInt("page", 1)
+	if page < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid page"})
+	}
+
+	status := models.ReportStatus(c.Query("status", string(models.ReportStatusOpen)))
+	reports, err := h.service.ListReports(status, models.ReportTargetType(c.Query("targetType")), page)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(reports)
+}
+
+// @Summary Get a report
+// @Description Get a report along with every moderation decision taken on its target, newest first
+// @Tags Moderation
+// @Produce json
+// @Param id path int true "Report ID"
+// @Success 200 {object} dto.ReportDetailResponse
+// @Failure 400 {object} map[string]string "error: invalid report id"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: report not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /sysadmin/reports/{id} [get]
+func (h *ModerationHandler) GetReport(c *fiber.Ctx) error {
+	reportID, err := utils.GetParamFormFiberCtx(c, "id", "report")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	report, err := h.service.GetReport(reportID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(report)
+}
+
+// @Summary Dismiss a report
+// @Description Close every open report on the target of a report without acting on it
+// @Tags Moderation
+// @Accept json
+// @Produce json
+// @Param id path int true "Report ID"
+// @Param body body dto.ModerationRequest false "Optional note for the audit trail"
+// @Success 200 {object} dto.ModerationDecisionResponse
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: report not found"
+// @Failure 409 {object} map[string]string "error: report has already been resolved"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /sysadmin/reports/{id}/dismiss [post]
+func (h *ModerationHandler) Dismiss(c *fiber.Ctx) error {
+	return h.moderate(c, h.service.Dismiss)
+}
+
+// @Summary Hide reported content
+// @Description Hide the reported event or job from the platform and search, close every open report on it and notify its organization
+// @Tags Moderation
+// @Accept json
+// @Produce json
+// @Param id path int true "Report ID"
+// @Param body body dto.ModerationRequest false "Optional note for the organization"
+// @Success 200 {object} dto.ModerationDecisionResponse
+// @Failure 400 {object} map[string]string "error: only events and jobs can be hidden"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: report not found"
+// @Failure 409 {object} map[string]string "error: report has already been resolved"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /sysadmin/reports/{id}/hide [post]
+func (h *ModerationHandler) Hide(c *fiber.Ctx) error {
+	return h.moderate(c, h.service.Hide)
+}
+
+// @Summary Suspend a reported organization
+// @Description Suspend the reported organization, or the one owning the reported event or job. It is removed from search along with its events and jobs.
+// @Tags Moderation
+// @Accept json
+// @Produce json
+// @Param id path int true "Report ID"
+// @Param body body dto.ModerationRequest true "Reason shown to the organization"
+// @Success 200 {object} dto.ModerationDecisionResponse
+// @Failure 400 {object} map[string]string "error: a note is required to suspend an organization"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: report not found"
+// @Failure 409 {object} map[string]string "error: report has already been resolved"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /sysadmin/reports/{id}/suspend-organization [post]
+func (h *ModerationHandler) SuspendOrganization(c *fiber.Ctx) error {
+	return h.moderate(c, h.service.SuspendOrganization)
+}
+
+// @Summary Reinstate the content of an actioned report
+// @Description Reverse the hide or suspension an actioned report led to. The content is indexed in search again.
+// @Tags Moderation
+// @Accept json
+// @Produce json
+// @Param id path int true "Report ID"
+// @Param body body dto.ModerationRequest true "Reason shown to the organization"
+// @Success 200 {object} dto.ModerationDecisionResponse
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: report not found"
+// @Failure 409 {object} map[string]string "error: only an actioned report can be reinstated"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /sysadmin/reports/{id}/reinstate [post]
+func (h *ModerationHandler) Reinstate(c *fiber.Ctx) error {
+	return h.moderate(c, h.service.Reinstate)
+}
+
+func (h *ModerationHandler) moderate(c *fiber.Ctx,
+	action func(moderatorID uuid.UUID, reportID uint, note string) (*dto.ModerationDecisionResponse, error)) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	reportID, err := utils.GetParamFormFiberCtx(c, "id", "report")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.ModerationRequest
+	if len(c.Body()) > 0 {
+		if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+			return err
+		}
+	}
+
+	decision, err := action(userID, reportID, req.Note)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(decision)
+}
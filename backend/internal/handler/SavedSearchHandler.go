@@ -0,0 +1,190 @@
+package handler
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type SavedSearchHandler struct {
+	service service.SavedSearchService
+}
+
+func NewSavedSearchHandler(service service.SavedSearchService) *SavedSearchHandler {
+	return &SavedSearchHandler{service: service}
+}
+
+// @Summary List my saved searches
+// @Description List the saved job and event searches of the current user, newest first
+// @Tags Saved Searches
+// @Produce json
+// @Success 200 {array} dto.SavedSearchResponse
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/saved-searches [get]
+func (h *SavedSearchHandler) ListSavedSearches(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	savedSearches, err := h.service.ListSavedSearches(userID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(savedSearches)
+}
+
+// @Summary Get one of my saved searches
+// @Description Get a saved search of the current user by its ID
+// @Tags Saved Searches
+// @Produce json
+// @Param id path int true "Saved search ID"
+// @Success 200 {object} dto.SavedSearchResponse
+// @Failure 400 {object} map[string]string "error: invalid saved search id"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 404 {object} map[string]string "error: saved search not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/saved-searches/{id} [get]
+func (h *SavedSearchHandler) GetSavedSearch(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	id, err := utils.GetParamFormFiberCtx(c, "id", "saved search")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	savedSearch, err := h.service.GetSavedSearch(userID, id)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(savedSearch)
+}
+
+// @Summary Save a search
+// @Description Save a job or event search and get its new results by email as a daily or weekly digest.
+// @Description The query takes the same fields as GET /jobs-paginate/search or GET /events-paginate/search, without paging.
+// @Tags Saved Searches
+// @Accept json
+// @Produce json
+// @Param body body dto.SavedSearchRequest true "Saved search"
+// @Success 201 {object} dto.SavedSearchResponse
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/saved-searches [post]
+func (h *SavedSearchHandler) CreateSavedSearch(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.SavedSearchRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	savedSearch, err := h.service.CreateSavedSearch(userID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(savedSearch)
+}
+
+// @Summary Update a saved search
+// @Description Rename a saved search, change its query or digest frequency, or turn its emails on or off. The kind cannot be changed.
+// @Tags Saved Searches
+// @Accept json
+// @Produce json
+// @Param id path int true "Saved search ID"
+// @Param body body dto.UpdateSavedSearchRequest true "Saved search"
+// @Success 200 {object} dto.SavedSearchResponse
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 404 {object} map[string]string "error: saved search not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/saved-searches/{id} [put]
+func (h *SavedSearchHandler) UpdateSavedSearch(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	id, err := utils.GetParamFormFiberCtx(c, "id", "saved search")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.UpdateSavedSearchRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	savedSearch, err := h.service.UpdateSavedSearch(userID, id, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(savedSearch)
+}
+
+// @Summary Delete a saved search
+// @Description Delete a saved search of the current user and stop its digest
+// @Tags Saved Searches
+// @Produce json
+// @Param id path int true "Saved search ID"
+// @Success 200 {object} map[string]string "message: saved search deleted successfully"
+// @Failure 400 {object} map[string]string "error: invalid saved search id"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 404 {object} map[string]string "error: saved search not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/saved-searches/{id} [delete]
+func (h *SavedSearchHandler) DeleteSavedSearch(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	id, err := utils.GetParamFormFiberCtx(c, "id", "saved search")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.service.DeleteSavedSearch(userID, id); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "saved search deleted successfully"})
+}
+
+// @Summary Unsubscribe from a saved search digest
+// @Description Turn off the email digest of a saved search using the token from the unsubscribe link. No login is required.
+// @Tags Saved Searches
+// @Accept json
+// @Produce json
+// @Param body body dto.UnsubscribeSavedSearchRequest true "Unsubscribe token"
+// @Success 200 {object} map[string]string "message: unsubscribed successfully"
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 404 {object} map[string]string "error: invalid unsubscribe link"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /saved-searches/unsubscribe [post]
+func (h *SavedSearchHandler) Unsubscribe(c *fiber.Ctx) error {
+	var req dto.UnsubscribeSavedSearchRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	if err := h.service.Unsubscribe(req.Token); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "unsubscribed successfully"})
+}
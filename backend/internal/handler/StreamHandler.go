@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/infrastructure/stream"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/logs"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+const (
+	streamHeartbeatInterval = 25 * time.Second
+	// streamWriteGrace is how long a write may block before the client is considered gone. It
+	// replaces the server write timeout, which would otherwise end every stream after 30 seconds.
+	streamWriteGrace = streamHeartbeatInterval + 10*time.Second
+	streamRetry      = 3 * time.Second
+)
+
+type StreamHandler struct {
+	hub            *stream.Hub
+	sessionService service.SessionService
+}
+
+func NewStreamHandler(hub *stream.Hub, sessionService service.SessionService) *StreamHandler {
+	return &StreamHandler{hub: hub, sessionService: sessionService}
+}
+
+// @Summary Stream my live events
+// @Description Server-sent events for the current user: notification, invitation, ticket-check-in (a ticket of the user was scanned)
+// @Description and event-check-in (a ticket was scanned at an event of an organization of the user).
+// @Description The stream is closed once the session is logged out, revoked or the user suspended.
+// @Description Reconnect with the Last-Event-ID header, or the lastEventId query, to replay missed events.
+// @Tags Notifications
+// @Produce text/event-stream
+// @Param Last-Event-ID header string false "ID of the last event received"
+// @Param lastEventId query string false "Same as the Last-Event-ID header, for clients that cannot set it"
+// @Success 200 {string} string "event stream"
+// @Failure 400 {object} map[string]string "error: invalid Last-Event-ID"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Router /users/me/stream [get]
+func (h *StreamHandler) Stream(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+	sessionID, err := utils.GetSessionIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	lastEventID := c.Get("Last-Event-ID", c.Query("lastEventId"))
+	var after uint64
+	if lastEventID != "" {
+		after, err = strconv.ParseUint(lastEventID, 10, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid Last-Event-ID"})
+		}
+	}
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+
+	client, missed := h.hub.Subscribe(userID, after)
+	conn := c.Context().Conn()
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer h.hub.Unsubscribe(client)
+
+		flush := func() bool {
+			_ = conn.SetWriteDeadline(time.Now().Add(streamWriteGrace))
+			return w.Flush() == nil
+		}
+
+		fmt.Fprintf(w, "retry: %d\n\n", streamRetry.Milliseconds())
+		for _, event := range missed {
+			writeStreamEvent(w, event)
+		}
+		if !flush() {
+			return
+		}
+
+		heartbeat := time.NewTicker(streamHeartbeatInterval)
+		defer heartbeat.Stop()
+
+		for {
+			select {
+			case event := <-client.Events():
+				writeStreamEvent(w, event)
+			case <-heartbeat.C:
+				// The token was only checked when the stream opened
+				if !h.sessionActive(sessionID) {
+					return
+				}
+				fmt.Fprint(w, ": heartbeat\n\n")
+			case <-client.Dropped():
+				// The client fell too far behind; closing makes it reconnect and replay
+				return
+			}
+			if !flush() {
+				return
+			}
+		}
+	})
+
+	return nil
+}
+
+// sessionActive reports whether the stream of a session may stay open. On a failed check the
+// stream is closed as well; the client reconnects through the auth middleware.
+func (h *StreamHandler) sessionActive(sessionID uuid.UUID) bool {
+	active, err := h.sessionService.IsSessionActive(sessionID)
+	if err != nil {
+		logs.Error(fmt.Sprintf("Failed to check the session of a stream: %v", err))
+		return false
+	}
+	return active
+}
+
+func writeStreamEvent(w *bufio.Writer, event stream.Event) {
+	fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Name, event.Data)
+}
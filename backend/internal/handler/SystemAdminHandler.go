@@ -0,0 +1,414 @@
+package handler
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type SystemAdminHandler struct {
+	service service.SystemAdminService
+}
+
+func NewSystemAdminHandler(service service.SystemAdminService) *SystemAdminHandler {
+	return &SystemAdminHandler{service: service}
+}
+
+// @Summary Search users
+// @Description Search every user of the platform by name or email, newest first, 20 per page
+// @Tags System Admin
+// @Produce json
+// @Param q query string false "Part of a name or email"
+// @Param page query int false "Page number"
+// @Success 200 {object} dto.PaginatedSysAdminUsersResponse
+// @Failure 400 {object} map[string]string "error: invalid page"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /sysadmin/users [get]
+func (h *SystemAdminHandler) SearchUsers(c *fiber.Ctx) error {
+	page := c.QueryInt("page", 1)
+	if page < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid page"})
+	}
+
+	users, err := h.service.SearchUsers(c.Query("q"), page)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(users)
+}
+
+// @Summary Suspend a user
+// @Description Block a user from logging in and end all of their sessions
+// @Tags System Admin
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param body body dto.SuspendUserRequest true "Reason for the suspension"
+// @Success 200 {object} dto.SysAdminUserResponse
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: user not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /sysadmin/users/{id}/suspend [post]
+func (h *SystemAdminHandler) SuspendUser(c *fiber.Ctx) error {
+	adminID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	userID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid user ID"})
+	}
+
+	var req dto.SuspendUserRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	user, err := h.service.SuspendUser(adminID, userID, req.Reason)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(user)
+}
+
+// @Summary Reinstate a user
+// @Description Lift the suspension of a user so they can log in again
+// @Tags System Admin
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} dto.SysAdminUserResponse
+// @Failure 400 {object} map[string]string "error: Invalid user ID"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: user not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /sysadmin/users/{id}/reinstate [post]
+func (h *SystemAdminHandler) ReinstateUser(c *fiber.Ctx) error {
+	userID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid user ID"})
+	}
+
+	user, err := h.service.ReinstateUser(userID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(user)
+}
+
+// @Summary Log a user out everywhere
+// @Description End every session of a user. Their access tokens stop working on the next request.
+// @Tags System Admin
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} map[string]string "message: user logged out of all devices"
+// @Failure 400 {object} map[string]string "error: Invalid user ID"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: user not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /sysadmin/users/{id}/logout [post]
+func (h *SystemAdminHandler) ForceLogout(c *fiber.Ctx) error {
+	userID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid user ID"})
+	}
+
+	if err := h.service.ForceLogout(userID); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "user logged out of all devices"})
+}
+
+// @Summary Make a user a system admin
+// @Description Grant the platform wide system_admin role to a user
+// @Tags System Admin
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} dto.SysAdminUserResponse
+// @Failure 400 {object} map[string]string "error: Invalid user ID"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: user not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /sysadmin/users/{id}/system-admin [put]
+func (h *SystemAdminHandler) GrantSystemAdmin(c *fiber.Ctx) error {
+	userID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid user ID"})
+	}
+
+	user, err := h.service.GrantSystemAdmin(userID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(user)
+}
+
+// @Summary Revoke the system admin role
+// @Description Take the platform wide system_admin role away from a user
+// @Tags System Admin
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} dto.SysAdminUserResponse
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: user is not a system admin"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /sysadmin/users/{id}/system-admin [delete]
+func (h *SystemAdminHandler) RevokeSystemAdmin(c *fiber.Ctx) error {
+	adminID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	userID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid user ID"})
+	}
+
+	user, err := h.service.RevokeSystemAdmin(adminID, userID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(user)
+}
+
+// @Summary List categories
+// @Description List every event and job category, including inactive ones, in sort order
+// @Tags System Admin
+// @Produce json
+// @Success 200 {array} dto.SysAdminCategoryResponse
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /sysadmin/categories [get]
+func (h *SystemAdminHandler) ListCategories(c *fiber.Ctx) error {
+	categories, err := h.service.ListCategories()
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(categories)
+}
+
+// @Summary Create a category
+// @Description Create an event and job category
+// @Tags System Admin
+// @Accept json
+// @Produce json
+// @Param body body dto.SysAdminCategoryRequest true "Category"
+// @Success 201 {object} dto.SysAdminCategoryResponse
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 409 {object} map[string]string "error: a category with this slug already exists"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /sysadmin/categories [post]
+func (h *SystemAdminHandler) CreateCategory(c *fiber.Ctx) error {
+	var req dto.SysAdminCategoryRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	category, err := h.service.CreateCategory(req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(category)
+}
+
+// @Summary Update a category
+// @Description Rename, move, reorder or deactivate a category
+// @Tags System Admin
+// @Accept json
+// @Produce json
+// @Param id path int true "Category ID"
+// @Param body body dto.SysAdminCategoryRequest true "Category"
+// @Success 200 {object} dto.SysAdminCategoryResponse
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: category not found"
+// @Failure 409 {object} map[string]string "error: a category with this slug already exists"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /sysadmin/categories/{id} [put]
+func (h *SystemAdminHandler) UpdateCategory(c *fiber.Ctx) error {
+	categoryID, err := utils.GetParamFormFiberCtx(c, "id", "category")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.SysAdminCategoryRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	category, err := h.service.UpdateCategory(categoryID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(category)
+}
+
+// @Summary Delete a category
+// @Description Delete a category. Prefer deactivating categories that are still in use.
+// @Tags System Admin
+// @Produce json
+// @Param id path int true "Category ID"
+// @Success 200 {object} map[string]string "message: category deleted successfully"
+// @Failure 400 {object} map[string]string "error: invalid category id"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: category not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /sysadmin/categories/{id} [delete]
+func (h *SystemAdminHandler) DeleteCategory(c *fiber.Ctx) error {
+	categoryID, err := utils.GetParamFormFiberCtx(c, "id", "category")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.service.DeleteCategory(categoryID); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "category deleted successfully"})
+}
+
+// @Summary List industries
+// @Description List every organization industry
+// @Tags System Admin
+// @Produce json
+// @Success 200 {array} dto.IndustryResponses
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /sysadmin/industries [get]
+func (h *SystemAdminHandler) ListIndustries(c *fiber.Ctx) error {
+	industries, err := h.service.ListIndustries()
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(industries)
+}
+
+// @Summary Create an industry
+// @Description Create an organization industry
+// @Tags System Admin
+// @Accept json
+// @Produce json
+// @Param body body dto.IndustryRequest true "Industry"
+// @Success 201 {object} dto.IndustryResponses
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /sysadmin/industries [post]
+func (h *SystemAdminHandler) CreateIndustry(c *fiber.Ctx) error {
+	var req dto.IndustryRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	industry, err := h.service.CreateIndustry(req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(industry)
+}
+
+// @Summary Rename an industry
+// @Description Rename an organization industry
+// @Tags System Admin
+// @Accept json
+// @Produce json
+// @Param id path int true "Industry ID"
+// @Param body body dto.IndustryRequest true "Industry"
+// @Success 200 {object} dto.IndustryResponses
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: industry not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /sysadmin/industries/{id} [put]
+func (h *SystemAdminHandler) UpdateIndustry(c *fiber.Ctx) error {
+	industryID, err := utils.GetParamFormFiberCtx(c, "id", "industry")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.IndustryRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	industry, err := h.service.UpdateIndustry(industryID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(industry)
+}
+
+// @Summary Delete an industry
+// @Description Delete an organization industry and remove it from the organizations that use it
+// @Tags System Admin
+// @Produce json
+// @Param id path int true "Industry ID"
+// @Success 200 {object} map[string]string "message: industry deleted successfully"
+// @Failure 400 {object} map[string]string "error: invalid industry id"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 404 {object} map[string]string "error: industry not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /sysadmin/industries/{id} [delete]
+func (h *SystemAdminHandler) DeleteIndustry(c *fiber.Ctx) error {
+	industryID, err := utils.GetParamFormFiberCtx(c, "id", "industry")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.service.DeleteIndustry(industryID); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "industry deleted successfully"})
+}
+
+// @Summary Platform stats
+// @Description Counts of users, organizations, events, jobs, applications and tickets across the platform
+// @Tags System Admin
+// @Produce json
+// @Success 200 {object} dto.PlatformStatsResponse
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 403 {object} map[string]string "error: You are not authorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /sysadmin/stats [get]
+func (h *SystemAdminHandler) GetPlatformStats(c *fiber.Ctx) error {
+	stats, err := h.service.GetPlatformStats()
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(stats)
+}
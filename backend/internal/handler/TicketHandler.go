@@ -0,0 +1,363 @@
+package handler
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type TicketHandler struct {
+	ticketService   models.TicketAvailableService
+	purchaseService models.TicketPurchasedService
+}
+
+func NewTicketHandler(ticketService models.TicketAvailableService, purchaseService models.TicketPurchasedService) *TicketHandler {
+	return &TicketHandler{
+		ticketService:   ticketService,
+		purchaseService: purchaseService,
+	}
+}
+
+// @Summary List tickets of an event
+// @Description List every ticket type sold for an event with its remaining quantity
+// @Tags Tickets
+// @Produce json
+// @Param id path int true "Event ID"
+// @Success 200 {array} dto.TicketAvailableResponse
+// @Failure 400 {object} map[string]string "error: invalid event id"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /events/{id}/tickets [get]
+func (h *TicketHandler) ListTicketsByEventID(c *fiber.Ctx) error {
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	tickets, err := h.ticketService.GetAllByEventID(eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.BuildListTicketAvailableResponse(tickets))
+}
+
+// @Summary List tickets of an event of the organization
+// @Description List every ticket type of an event of the organization, including a hidden event
+// @Tags Tickets
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Success 200 {array} dto.TicketAvailableResponse
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/tickets [get]
+func (h *TicketHandler) ListOrgTicketsByEventID(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	tickets, err := h.ticketService.GetAllByEventIDwithOrgID(orgID, eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.BuildListTicketAvailableResponse(tickets))
+}
+
+// @Summary Create a ticket for an event
+// @Description Create a ticket type for a paid event of the organization
+// @Tags Tickets
+// @Accept json
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param ticket body dto.TicketAvailableRequest true "Ticket"
+// @Success 201 {object} dto.TicketAvailableResponse
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/tickets [post]
+func (h *TicketHandler) CreateTicket(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.TicketAvailableRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	ticket := models.TicketAvailable{
+		Title:       req.Title,
+		Description: req.Description,
+		Quantity:    req.Quantity,
+		Price:       req.Price,
+	}
+	if err := h.ticketService.Create(orgID, eventID, &ticket); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(dto.BuildTicketAvailableResponse(ticket))
+}
+
+// @Summary Update a ticket of an event
+// @Description Update title, description, remaining quantity or price of a ticket type
+// @Tags Tickets
+// @Accept json
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param ticketID path int true "Ticket ID"
+// @Param ticket body dto.TicketAvailableRequest true "Ticket"
+// @Success 200 {object} map[string]string "message: ticket updated successfully"
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 404 {object} map[string]string "error: ticket not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/tickets/{ticketID} [put]
+func (h *TicketHandler) UpdateTicket(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	ticketID, err := utils.GetParamFormFiberCtx(c, "ticketID", "ticket")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.TicketAvailableRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	ticket := models.TicketAvailable{
+		Title:       req.Title,
+		Description: req.Description,
+		Quantity:    req.Quantity,
+		Price:       req.Price,
+	}
+	ticket.ID = ticketID
+	if err := h.ticketService.Update(orgID, eventID, &ticket); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "ticket updated successfully"})
+}
+
+// @Summary Delete a ticket of an event
+// @Description Delete a ticket type, tickets already purchased are kept
+// @Tags Tickets
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param ticketID path int true "Ticket ID"
+// @Success 200 {object} map[string]string "message: ticket deleted successfully"
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 404 {object} map[string]string "error: ticket not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/tickets/{ticketID} [delete]
+func (h *TicketHandler) DeleteTicket(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	ticketID, err := utils.GetParamFormFiberCtx(c, "ticketID", "ticket")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.ticketService.Delete(orgID, eventID, ticketID); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "ticket deleted successfully"})
+}
+
+// @Summary Purchase a ticket
+// @Description Purchase one ticket of a paid event for the current user
+// @Tags Tickets
+// @Accept json
+// @Produce json
+// @Param id path int true "Event ID"
+// @Param ticketID path int true "Ticket ID"
+// @Param purchase body dto.PurchaseTicketRequest false "Contact phone"
+// @Success 201 {object} dto.TicketPurchasedResponse
+// @Failure 400 {object} map[string]string "error: this event is free, no ticket is required"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 404 {object} map[string]string "error: ticket not found"
+// @Failure 409 {object} map[string]string "error: ticket is sold out"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /events/{id}/tickets/{ticketID}/purchase [post]
+func (h *TicketHandler) PurchaseTicket(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	ticketID, err := utils.GetParamFormFiberCtx(c, "ticketID", "ticket")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	// The body is optional, it only carries the buyer's contact phone
+	var req dto.PurchaseTicketRequest
+	if len(c.Body()) > 0 {
+		if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+			return err
+		}
+	}
+
+	purchase, err := h.purchaseService.Purchase(userID, eventID, ticketID, req.Phone)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(dto.BuildTicketPurchasedResponse(*purchase))
+}
+
+// @Summary List my tickets
+// @Description List every ticket purchased by the current user, newest first
+// @Tags Tickets
+// @Produce json
+// @Success 200 {array} dto.TicketPurchasedResponse
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/tickets [get]
+func (h *TicketHandler) ListMyTickets(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	purchases, err := h.purchaseService.GetAllByUserID(userID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.BuildListTicketPurchasedResponse(purchases))
+}
+
+// @Summary Get one of my tickets
+// @Description Get a ticket purchased by the current user
+// @Tags Tickets
+// @Produce json
+// @Param id path int true "Purchased ticket ID"
+// @Success 200 {object} dto.TicketPurchasedResponse
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 404 {object} map[string]string "error: ticket not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/tickets/{id} [get]
+func (h *TicketHandler) GetMyTicket(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+	id, err := utils.GetParamFormFiberCtx(c, "id", "ticket")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	purchase, err := h.purchaseService.GetByIDAndUserID(userID, id)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.BuildTicketPurchasedResponse(*purchase))
+}
+
+// @Summary Check in a ticket
+// @Description Verify the signed QR payload of a ticket at the door and mark it as used. A second scan is rejected.
+// @Tags Tickets
+// @Accept json
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Param body body dto.CheckInRequest true "Scanned QR payload"
+// @Success 200 {object} dto.CheckInResponse
+// @Failure 400 {object} map[string]string "error: invalid qr code / ticket belongs to another event"
+// @Failure 404 {object} map[string]string "error: ticket not found"
+// @Failure 409 {object} map[string]string "error: ticket already used"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/check-in [post]
+func (h *TicketHandler) CheckIn(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.CheckInRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	purchase, err := h.purchaseService.CheckIn(orgID, eventID, req.Qrcode)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	total, checkedIn, err := h.purchaseService.GetAttendance(orgID, eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.CheckInResponse{
+		Ticket:     dto.BuildTicketPurchasedResponse(*purchase),
+		Attendance: dto.AttendanceResponse{EventID: eventID, Total: total, CheckedIn: checkedIn},
+	})
+}
+
+// @Summary Get live attendance of an event
+// @Description Get the number of tickets sold and checked in for an event
+// @Tags Tickets
+// @Produce json
+// @Param orgID path int true "Organization ID"
+// @Param id path int true "Event ID"
+// @Success 200 {object} dto.AttendanceResponse
+// @Failure 404 {object} map[string]string "error: event not found"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /admin/orgs/{orgID}/events/{id}/check-in/attendance [get]
+func (h *TicketHandler) GetAttendance(c *fiber.Ctx) error {
+	orgID, err := utils.GetOrgIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	eventID, err := utils.GetParamFormFiberCtx(c, "id", "event")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	total, checkedIn, err := h.purchaseService.GetAttendance(orgID, eventID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.AttendanceResponse{EventID: eventID, Total: total, CheckedIn: checkedIn})
+}
@@ -3,6 +3,8 @@ package handler
 import (
 	"fmt"
 
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
 	"github.com/DAF-Bridge/asaiasa-Backend/utils"
 
@@ -46,11 +48,14 @@ func (h *UserHandler) CreateUser(c *fiber.Ctx) error {
 }
 
 // @Summary List all users
-// @Description List all users
+// @Description List all users. Only system admins may call this.
 // @Tags Users
 // @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Success 200 {array} models.User
+// @Failure 401 {object} fiber.Map "Unauthorized"
+// @Failure 403 {object} fiber.Map "Forbidden - not a system admin"
 // @Failure 500 {object} fiber.Map "Internal server error - Internal Server Error"
 // @Router /users [get]
 func (h *UserHandler) ListUsers(c *fiber.Ctx) error {
@@ -197,6 +202,92 @@ func (h *UserHandler) UploadProfilePicture(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{"picUrl": picURL})
 }
 
+type PublicProfileHandler struct {
+	service service.PublicProfileService
+}
+
+func NewPublicProfileHandler(service service.PublicProfileService) *PublicProfileHandler {
+	return &PublicProfileHandler{service: service}
+}
+
+// @Summary Get a public user profile
+// @Description Get the public profile of a user. Sections the user has hidden are left out.
+// @Tags Users
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} dto.PublicProfileResponse
+// @Failure 400 {object} fiber.Map "Bad request - Invalid user ID"
+// @Failure 404 {object} fiber.Map "Profile not found"
+// @Failure 500 {object} fiber.Map "Internal server error"
+// @Router /users/{id}/profile [get]
+func (h *PublicProfileHandler) GetPublicProfile(c *fiber.Ctx) error {
+	userID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid user ID"})
+	}
+
+	profile, err := h.service.GetPublicProfile(userID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(profile)
+}
+
+// @Summary Get my profile visibility
+// @Description Get which sections of the current user's profile are public
+// @Tags Users
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.ProfileVisibilityResponse
+// @Failure 401 {object} fiber.Map "Unauthorized"
+// @Failure 500 {object} fiber.Map "Internal server error"
+// @Router /users/me/profile-visibility [get]
+func (h *PublicProfileHandler) GetProfileVisibility(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	visibility, err := h.service.GetProfileVisibility(userID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(visibility)
+}
+
+// @Summary Update my profile visibility
+// @Description Choose which sections of the current user's profile are public
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body dto.ProfileVisibilityRequest true "Visibility settings"
+// @Success 200 {object} dto.ProfileVisibilityResponse
+// @Failure 400 {object} fiber.Map "Bad request"
+// @Failure 401 {object} fiber.Map "Unauthorized"
+// @Failure 500 {object} fiber.Map "Internal server error"
+// @Router /users/me/profile-visibility [put]
+func (h *PublicProfileHandler) UpdateProfileVisibility(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.ProfileVisibilityRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	visibility, err := h.service.UpdateProfileVisibility(userID, req)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(visibility)
+}
+
 type UserPreferenceHandler struct {
 	service service.UserPreferenceService
 }
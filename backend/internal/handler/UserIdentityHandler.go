@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/logs"
+	"github.com/DAF-Bridge/asaiasa-Backend/pkg/oauthprovider"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+type UserIdentityHandler struct {
+	service   models.UserIdentityService
+	providers oauthprovider.Registry
+}
+
+func NewUserIdentityHandler(service models.UserIdentityService, providers oauthprovider.Registry) *UserIdentityHandler {
+	return &UserIdentityHandler{service: service, providers: providers}
+}
+
+// @Summary List my login methods
+// @Description List whether the current user has a password and which external accounts are linked
+// @Tags User Identities
+// @Produce json
+// @Success 200 {object} dto.LoginMethodsResponse
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/identities [get]
+func (h *UserIdentityHandler) ListLoginMethods(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	hasPassword, identities, err := h.service.ListLoginMethods(userID)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(dto.BuildLoginMethodsResponse(hasPassword, identities))
+}
+
+// @Summary Add a password
+// @Description Enable email and password login for an account that signed up with an external provider
+// @Tags User Identities
+// @Accept json
+// @Produce json
+// @Param body body dto.LinkPasswordRequest true "New password"
+// @Success 201 {object} map[string]string "message: password linked successfully"
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 409 {object} map[string]string "error: a password is already set"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/identities/password [post]
+func (h *UserIdentityHandler) LinkPassword(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	var req dto.LinkPasswordRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	if err := h.service.LinkPassword(userID, req.Password); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{"message": "password linked successfully"})
+}
+
+// @Summary Remove the password
+// @Description Disable password login. Fails when it is the only way to sign in.
+// @Tags User Identities
+// @Produce json
+// @Success 200 {object} map[string]string "message: password unlinked successfully"
+// @Failure 400 {object} map[string]string "error: cannot remove the last login method"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 404 {object} map[string]string "error: no password is set"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/identities/password [delete]
+func (h *UserIdentityHandler) UnlinkPassword(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.service.UnlinkPassword(userID); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "password unlinked successfully"})
+}
+
+// @Summary Link an external account
+// @Description Link the account behind an OAuth authorization code of an enabled provider to the current user
+// @Tags User Identities
+// @Accept json
+// @Produce json
+// @Param provider path string true "Provider" Enums(google, facebook)
+// @Param body body dto.LinkProviderRequest true "Authorization code"
+// @Success 201 {object} dto.UserIdentityResponse
+// @Failure 400 {object} map[string]string "error: Bad Request"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 404 {object} map[string]string "error: unsupported login provider"
+// @Failure 409 {object} map[string]string "error: this google account is already linked to another user"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/identities/{provider} [post]
+func (h *UserIdentityHandler) LinkProvider(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	provider, ok := h.providers.Get(c.Params("provider"))
+	if !ok {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "unsupported login provider"})
+	}
+
+	var req dto.LinkProviderRequest
+	if err := utils.ParseJSONAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	userInfo, err := provider.FetchUserInfo(c.Context(), req.Code)
+	if err != nil {
+		logs.Error(err.Error())
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	identity, err := h.service.LinkProvider(userID, models.Provider(provider.Name), userInfo.ProviderID, userInfo.Email)
+	if err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(dto.BuildUserIdentityResponse(*identity))
+}
+
+// @Summary Unlink an external account
+// @Description Unlink an external login provider. Fails when it is the only way to sign in.
+// @Tags User Identities
+// @Produce json
+// @Param provider path string true "Provider" Enums(google, facebook)
+// @Success 200 {object} map[string]string "message: account unlinked successfully"
+// @Failure 400 {object} map[string]string "error: cannot remove the last login method"
+// @Failure 401 {object} map[string]string "error: Unauthorized"
+// @Failure 404 {object} map[string]string "error: no google account is linked"
+// @Failure 500 {object} map[string]string "error: Internal Server Error"
+// @Router /users/me/identities/{provider} [delete]
+func (h *UserIdentityHandler) UnlinkProvider(c *fiber.Ctx) error {
+	userID, err := utils.GetUserIDFormFiberCtx(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	if err := h.service.UnlinkProvider(userID, models.Provider(c.Params("provider"))); err != nil {
+		return errs.SendFiberError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{"message": "account unlinked successfully"})
+}
@@ -1,8 +1,10 @@
 package api
 
 import (
+	"encoding/json"
 	"html/template"
 
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/handler"
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
@@ -13,24 +15,38 @@ import (
 	"gorm.io/gorm"
 )
 
-func NewRoleRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, mail *gomail.Dialer, jwtSecret string,
+func NewRoleRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, mail *gomail.Dialer, jwtSecret string, sessionService service.SessionService,
 	tmpl *template.Template,
-	baseCallbackInviteURL string) {
+	baseCallbackInviteURL string,
+	notifications service.NotificationPublisher) {
 	dbRoleRepository := repository.NewDBRoleRepository(db)
 	enforcerRoleRepository := repository.NewCasbinRoleRepository(enforcer)
 	userRepository := repository.NewUserRepository(db)
 	organizationRepository := repository.NewOrganizationRepository(db)
 	inviteTokenRepository := repository.NewInviteTokenRepository(db)
 	inviteMailRepository := repository.NewInviteMailRepository(mail, tmpl, baseCallbackInviteURL)
-	authMiddleware := middleware.AuthMiddleware(jwtSecret)
+	authMiddleware := middleware.AuthMiddleware(jwtSecret, sessionService)
 
-	roleService := service.NewRoleWithDomainService(dbRoleRepository, enforcerRoleRepository, userRepository, organizationRepository, inviteTokenRepository, inviteMailRepository)
+	roleService := service.NewRoleWithDomainService(dbRoleRepository, enforcerRoleRepository, userRepository, organizationRepository, inviteTokenRepository, inviteMailRepository, notifications)
 	roleHandler := handler.NewRoleHandler(roleService)
 
 	app.Post("/callback-invitation", roleHandler.CallBackInvitationForMember)
 	app.Post("/updated-enforcer", roleHandler.UpdateRoleToEnforcer)
 
-	rbac := middleware.NewRBACMiddleware(enforcer)
+	// Only the member and their role are recorded, not the whole user
+	audit := middleware.NewAuditMiddleware(repository.NewAuditLogRepository(db)).
+		Snapshot("Role", func(c *fiber.Ctx, orgID uint) (interface{}, error) {
+			var member dto.RemoveMemberRequest
+			if err := json.Unmarshal(c.Body(), &member); err != nil {
+				return nil, err
+			}
+			role, err := roleService.GetRolesForUserInDomain(member.UserID, orgID)
+			if err != nil {
+				return nil, err
+			}
+			return fiber.Map{"user_id": role.UserID, "role": role.Role}, nil
+		})
+	rbac := middleware.NewRBACMiddleware(enforcer).WithAudit(audit)
 	app.Get("/admin/my-orgs", authMiddleware, roleHandler.GetDomainsByUser)
 	role := app.Group("admin/roles/orgs/:orgID", authMiddleware)
 	role.Get("/", rbac.EnforceMiddleware("Role", "read"), roleHandler.GetRolesForUserInDomain)
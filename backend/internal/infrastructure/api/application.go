@@ -0,0 +1,38 @@
+package api
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/handler"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/infrastructure"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/middleware"
+	"github.com/casbin/casbin/v2"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+func NewApplicationRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, s3 *infrastructure.S3Uploader, jwtSecret string, sessionService service.SessionService) {
+	// Dependencies Injections for Job Applications
+	applicationRepo := repository.NewApplicationRepository(db)
+	orgOpenJobRepo := repository.NewOrgOpenJobRepository(db)
+	applicationService := service.NewApplicationService(applicationRepo, orgOpenJobRepo, s3)
+	applicationHandler := handler.NewApplicationHandler(applicationService)
+
+	authMiddleware := middleware.AuthMiddleware(jwtSecret, sessionService)
+	rbac := middleware.NewRBACMiddleware(enforcer).WithAudit(middleware.NewAuditMiddleware(repository.NewAuditLogRepository(db)))
+	enforceMiddlewareWithApplication := rbac.EnforceMiddlewareWithResources("Application")
+
+	// Applicant
+	app.Post("/jobs/:jobID/apply", authMiddleware, applicationHandler.Apply)
+	app.Get("/users/me/applications", authMiddleware, applicationHandler.ListMyApplications)
+	app.Get("/users/me/applications/:id", authMiddleware, applicationHandler.GetMyApplication)
+	app.Get("/users/me/applications/:id/resume", authMiddleware, applicationHandler.GetMyResume)
+	app.Post("/users/me/applications/:id/withdraw", authMiddleware, applicationHandler.Withdraw)
+
+	// Organization hiring pipeline
+	applications := app.Group("/admin/orgs/:orgID/jobs/:jobID/applications", authMiddleware)
+	applications.Get("/", enforceMiddlewareWithApplication("read"), applicationHandler.ListJobApplications)
+	applications.Get("/:id", enforceMiddlewareWithApplication("read"), applicationHandler.GetJobApplication)
+	applications.Get("/:id/resume", enforceMiddlewareWithApplication("read"), applicationHandler.GetApplicantResume)
+	applications.Patch("/:id/status", enforceMiddlewareWithApplication("update"), applicationHandler.UpdateStatus)
+}
@@ -0,0 +1,22 @@
+package api
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/handler"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/middleware"
+	"github.com/casbin/casbin/v2"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+func NewAuditLogRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, jwtSecret string, sessionService service.SessionService) {
+	// Dependencies Injections for the Organization Audit Log
+	auditLogRepo := repository.NewAuditLogRepository(db)
+	auditLogService := service.NewAuditLogService(auditLogRepo)
+	auditLogHandler := handler.NewAuditLogHandler(auditLogService)
+
+	rbac := middleware.NewRBACMiddleware(enforcer)
+
+	app.Get("/admin/orgs/:orgID/audit-log", middleware.AuthMiddleware(jwtSecret, sessionService), rbac.EnforceMiddleware("AuditLog", "read"), auditLogHandler.ListAuditLogs)
+}
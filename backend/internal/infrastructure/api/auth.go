@@ -1,42 +1,59 @@
 package api
 
 import (
+	"html/template"
+
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/handler"
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
 	"github.com/DAF-Bridge/asaiasa-Backend/middleware"
+	"github.com/DAF-Bridge/asaiasa-Backend/pkg/oauthprovider"
 	"github.com/gofiber/fiber/v2"
+	"gopkg.in/gomail.v2"
 	"gorm.io/gorm"
 )
 
-func NewAuthRouter(app *fiber.App, db *gorm.DB, jwtSecret string) {
+func NewAuthRouter(app *fiber.App, db *gorm.DB, oauthProviders oauthprovider.Registry, adminOAuthProviders oauthprovider.Registry, mail *gomail.Dialer, verifyEmailTmpl *template.Template, resetPasswordTmpl *template.Template,
+	baseVerifyEmailURL string, baseResetPasswordURL string, requireEmailVerification bool, jwtSecret string, sessionService service.SessionService) {
 	userRepo := repository.NewUserRepository(db)
 	profileRepo := repository.NewProfileRepository(db)
+	userTokenRepo := repository.NewUserTokenRepository(db)
+	identityRepo := repository.NewUserIdentityRepository(db)
+	authMailRepository := repository.NewAuthMailRepository(mail, verifyEmailTmpl, resetPasswordTmpl, baseVerifyEmailURL, baseResetPasswordURL)
 
 	// Dependencies Injections for Auth
-	authService := service.NewAuthService(userRepo, profileRepo, jwtSecret)
-	oauthService := service.NewOauthService(userRepo, profileRepo, jwtSecret)
-	authHandler := handler.NewAuthHandler(authService)
-	oauthHandler := handler.NewOauthHandler(oauthService)
+	authService := service.NewAuthService(userRepo, profileRepo, userTokenRepo, sessionService, authMailRepository, jwtSecret, requireEmailVerification)
+	oauthService := service.NewOauthService(userRepo, identityRepo, sessionService)
+	authHandler := handler.NewAuthHandler(authService, sessionService)
+	oauthHandler := handler.NewOauthHandler(oauthService, oauthProviders, adminOAuthProviders)
+
+	authMiddleware := middleware.AuthMiddleware(jwtSecret, sessionService)
 
-	app.Get("/auth/me", middleware.AuthMiddleware(jwtSecret), oauthHandler.Me)
+	app.Get("/auth/me", authMiddleware, oauthHandler.Me)
 	app.Post("/admin/login", authHandler.LogInAdmin)
 	app.Post("/admin/logout", authHandler.LogOutAdmin)
+	app.Post("/admin/auth/refresh", authHandler.RefreshAdmin)
 	app.Post("/signup", authHandler.SignUp)
 	app.Post("/login", authHandler.LogIn)
-	app.Get("/auth/google/callback", oauthHandler.GoogleCallback)
-	app.Get("/admin/auth/google/callback", oauthHandler.AdminGoogleCallback)
+	app.Get("/auth/:provider/callback", oauthHandler.Callback)
+	app.Get("/admin/auth/:provider/callback", oauthHandler.AdminCallback)
 	// app.Get("/auth/google", oauthHandler.GoogleLogin)
+	app.Post("/auth/refresh", authHandler.Refresh)
+	app.Post("/auth/verify-email", authHandler.VerifyEmail)
+	app.Post("/auth/verify-email/resend", authHandler.ResendVerificationEmail)
+	app.Post("/auth/forgot-password", authHandler.ForgotPassword)
+	app.Post("/auth/reset-password", authHandler.ResetPassword)
 	app.Post("/logout", authHandler.LogOut)
+	app.Post("/logout-all", authMiddleware, authHandler.LogOutAll)
 
-	app.Get("/protected-route", middleware.AuthMiddleware(jwtSecret), func(c *fiber.Ctx) error {
+	app.Get("/protected-route", authMiddleware, func(c *fiber.Ctx) error {
 		user := c.Locals("user")
 		return c.JSON(fiber.Map{
 			"message": "You are authenticated!",
 			"user":    user,
 		})
 	})
-	app.Get("/token-check", middleware.AuthMiddleware(jwtSecret), func(c *fiber.Ctx) error {
+	app.Get("/token-check", authMiddleware, func(c *fiber.Ctx) error {
 		return c.SendStatus(fiber.StatusOK)
 	})
 }
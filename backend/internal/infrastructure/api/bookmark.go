@@ -0,0 +1,23 @@
+package api
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/handler"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/middleware"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+func NewBookmarkRouter(app *fiber.App, db *gorm.DB, jwtSecret string, sessionService service.SessionService) {
+	// Dependencies Injections for Bookmarks
+	bookmarkRepo := repository.NewBookmarkRepository(db)
+	bookmarkService := service.NewBookmarkService(bookmarkRepo)
+	bookmarkHandler := handler.NewBookmarkHandler(bookmarkService)
+
+	bookmarks := app.Group("/users/me/bookmarks", middleware.AuthMiddleware(jwtSecret, sessionService))
+
+	bookmarks.Get("/", bookmarkHandler.ListBookmarks)
+	bookmarks.Post("/", bookmarkHandler.AddBookmark)
+	bookmarks.Delete("/:type/:targetID", bookmarkHandler.RemoveBookmark)
+}
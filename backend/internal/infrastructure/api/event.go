@@ -5,25 +5,29 @@ import (
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/infrastructure"
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/middleware"
 	"github.com/casbin/casbin/v2"
 	"github.com/gofiber/fiber/v2"
 	"github.com/opensearch-project/opensearch-go"
 	"gorm.io/gorm"
 )
 
-func NewEventRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, es *opensearch.Client, s3 *infrastructure.S3Uploader, jwtSecret string) {
+func NewEventRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, es *opensearch.Client, s3 *infrastructure.S3Uploader, jwtSecret string, sessionService service.SessionService) {
 	// Dependencies Injections for Event
 	eventRepo := repository.NewEventRepository(db)
 	opensearchRepo := repository.NewOpenSearchRepository(es)
-	eventService := service.NewEventService(eventRepo, opensearchRepo, db, es, s3)
-	eventHandler := handler.NewEventHandler(eventService)
+	eventService := service.NewEventService(eventRepo, opensearchRepo, db, es, s3, nil)
+	bookmarkRepo := repository.NewBookmarkRepository(db)
+	bookmarkService := service.NewBookmarkService(bookmarkRepo)
+	eventHandler := handler.NewEventHandler(eventService, bookmarkService)
+	optionalAuthMiddleware := middleware.OptionalAuthMiddleware(jwtSecret, sessionService)
 	//rbac := middleware.NewRBACMiddleware(enforcer)
 	//enforceMiddlewareWithEvent := rbac.EnforceMiddlewareWithResources("Event")
 
 	event := app.Group("/orgs/:orgID/events")
 
 	// Searching
-	app.Get("/events-paginate/search", eventHandler.SearchEvents)
+	app.Get("/events-paginate/search", optionalAuthMiddleware, eventHandler.SearchEvents)
 	// Sync PostGres to OpenSearch
 	app.Get("/sync-events", eventHandler.SyncEvents)
 
@@ -32,12 +36,12 @@ func NewEventRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, es *
 	// CRUD
 	event.Get("/", eventHandler.ListEventsByOrgID)
 	event.Get("/count", eventHandler.GetNumberOfEvents)
-	app.Get("/events-paginate", eventHandler.EventPaginate)
-	//event.Post("/create", middleware.AuthMiddleware(jwtSecret), enforceMiddlewareWithEvent("create"), eventHandler.CreateEvent)
+	app.Get("/events-paginate", optionalAuthMiddleware, eventHandler.EventPaginate)
+	//event.Post("/create", middleware.AuthMiddleware(jwtSecret, sessionService), enforceMiddlewareWithEvent("create"), eventHandler.CreateEvent)
 	app.Get("/events", eventHandler.ListEvents)
 	app.Get("/events/:id", eventHandler.GetEventByID)
 	event.Get("/:id", eventHandler.GetEventByIDwithOrgID)
-	//event.Put("/:id", middleware.AuthMiddleware(jwtSecret), enforceMiddlewareWithEvent("update"), eventHandler.UpdateEvent)
-	//event.Delete("/:id", middleware.AuthMiddleware(jwtSecret), enforceMiddlewareWithEvent("delete"), eventHandler.DeleteEvent)
-	//event.Get("/", middleware.AuthMiddleware(jwtSecret), eventHandler.ListEventsByOrgID)
+	//event.Put("/:id", middleware.AuthMiddleware(jwtSecret, sessionService), enforceMiddlewareWithEvent("update"), eventHandler.UpdateEvent)
+	//event.Delete("/:id", middleware.AuthMiddleware(jwtSecret, sessionService), enforceMiddlewareWithEvent("delete"), eventHandler.DeleteEvent)
+	//event.Get("/", middleware.AuthMiddleware(jwtSecret, sessionService), eventHandler.ListEventsByOrgID)
 }
@@ -6,28 +6,38 @@ import (
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
 	"github.com/DAF-Bridge/asaiasa-Backend/middleware"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
 	"github.com/casbin/casbin/v2"
 	"github.com/gofiber/fiber/v2"
 	"github.com/opensearch-project/opensearch-go"
 	"gorm.io/gorm"
 )
 
-func NewEventAdminRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, es *opensearch.Client, s3 *infrastructure.S3Uploader, jwtSecret string) {
+func NewEventAdminRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, es *opensearch.Client, s3 *infrastructure.S3Uploader, jwtSecret string, sessionService service.SessionService,
+	notifications service.NotificationPublisher) {
 	// Dependencies Injections for Event
 	eventRepo := repository.NewEventRepository(db)
 	opensearchRepo := repository.NewOpenSearchRepository(es)
-	eventService := service.NewEventService(eventRepo, opensearchRepo, db, es, s3)
-	eventHandler := handler.NewEventHandler(eventService)
-	rbac := middleware.NewRBACMiddleware(enforcer)
+	eventService := service.NewEventService(eventRepo, opensearchRepo, db, es, s3, notifications)
+	eventHandler := handler.NewEventHandler(eventService, nil)
+	audit := middleware.NewAuditMiddleware(repository.NewAuditLogRepository(db)).
+		Snapshot("Event", func(c *fiber.Ctx, orgID uint) (interface{}, error) {
+			id, err := utils.GetParamFormFiberCtx(c, "id", "event")
+			if err != nil {
+				return nil, err
+			}
+			return eventService.GetManagedEventByID(orgID, id)
+		})
+	rbac := middleware.NewRBACMiddleware(enforcer).WithAudit(audit)
 	enforceMiddlewareWithEvent := rbac.EnforceMiddlewareWithResources("Event")
 
-	event := app.Group("admin/orgs/:orgID/events", middleware.AuthMiddleware(jwtSecret))
+	event := app.Group("admin/orgs/:orgID/events", middleware.AuthMiddleware(jwtSecret, sessionService))
 
 	// CRUD
-	event.Get("/", enforceMiddlewareWithEvent("read"), eventHandler.ListEventsByOrgID)
+	event.Get("/", enforceMiddlewareWithEvent("read"), eventHandler.ListManagedEventsByOrgID)
 	event.Get("/count", enforceMiddlewareWithEvent("read"), eventHandler.GetNumberOfEvents)
-	event.Post("/create", enforceMiddlewareWithEvent("create"), enforceMiddlewareWithEvent("create"), eventHandler.CreateEvent)
-	event.Get("/:id", enforceMiddlewareWithEvent("read"), eventHandler.GetEventByIDwithOrgID)
+	event.Post("/create", enforceMiddlewareWithEvent("create"), eventHandler.CreateEvent)
+	event.Get("/:id", enforceMiddlewareWithEvent("read"), eventHandler.GetManagedEventByID)
 	event.Put("/:id", enforceMiddlewareWithEvent("update"), eventHandler.UpdateEvent)
 	event.Delete("/:id", enforceMiddlewareWithEvent("delete"), eventHandler.DeleteEvent)
 }
@@ -0,0 +1,36 @@
+package api
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/handler"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/middleware"
+	"github.com/casbin/casbin/v2"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+func NewEventParticipantRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, jwtSecret string, sessionService service.SessionService) {
+	// Dependencies Injections for Event Participant
+	participantRepo := repository.NewEventParticipantRepository(db)
+	eventRepo := repository.NewEventRepository(db)
+	participantService := service.NewEventParticipantService(participantRepo, eventRepo)
+	participantHandler := handler.NewEventParticipantHandler(participantService)
+
+	authMiddleware := middleware.AuthMiddleware(jwtSecret, sessionService)
+	rbac := middleware.NewRBACMiddleware(enforcer)
+
+	// Public attendee list
+	app.Get("/events/:id/participants", participantHandler.ListPublicParticipants)
+
+	// RSVP
+	app.Get("/events/:id/register", authMiddleware, participantHandler.GetMyRegistration)
+	app.Post("/events/:id/register", authMiddleware, participantHandler.Register)
+	app.Delete("/events/:id/register", authMiddleware, participantHandler.Unregister)
+	app.Patch("/events/:id/register/visibility", authMiddleware, participantHandler.UpdateVisibility)
+
+	// Organization admin
+	participant := app.Group("admin/orgs/:orgID/events/:id/participants", authMiddleware)
+	participant.Get("/", rbac.EnforceMiddleware("Event", "read"), participantHandler.ListParticipants)
+	participant.Get("/export", rbac.EnforceMiddleware("Event", "read"), participantHandler.ExportParticipantsCSV)
+}
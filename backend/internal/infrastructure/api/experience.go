@@ -0,0 +1,26 @@
+package api
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/handler"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/middleware"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+func NewExperienceRouter(app *fiber.App, db *gorm.DB, jwtSecret string, sessionService service.SessionService) {
+	// Dependencies Injections for Experiences
+	profileRepo := repository.NewProfileRepository(db)
+	experienceRepo := repository.NewExperienceRepository(db)
+	experienceService := service.NewExperienceService(profileRepo, experienceRepo)
+	experienceHandler := handler.NewExperienceHandler(experienceService)
+
+	experiences := app.Group("/users/me/experiences", middleware.AuthMiddleware(jwtSecret, sessionService))
+
+	experiences.Get("/", experienceHandler.ListExperiences)
+	experiences.Post("/", experienceHandler.CreateExperience)
+	experiences.Get("/:id", experienceHandler.GetExperience)
+	experiences.Put("/:id", experienceHandler.UpdateExperience)
+	experiences.Delete("/:id", experienceHandler.DeleteExperience)
+}
@@ -0,0 +1,25 @@
+package api
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/handler"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/middleware"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+func NewFollowRouter(app *fiber.App, db *gorm.DB, jwtSecret string, sessionService service.SessionService) {
+	// Dependencies Injections for Follows
+	followRepo := repository.NewOrganizationFollowRepository(db)
+	orgRepo := repository.NewOrganizationRepository(db)
+	followService := service.NewFollowService(followRepo, orgRepo)
+	followHandler := handler.NewFollowHandler(followService)
+
+	authMiddleware := middleware.AuthMiddleware(jwtSecret, sessionService)
+
+	app.Post("/orgs/:orgID/follow", authMiddleware, followHandler.Follow)
+	app.Delete("/orgs/:orgID/follow", authMiddleware, followHandler.Unfollow)
+	app.Get("/users/me/following", authMiddleware, followHandler.ListFollowing)
+	app.Get("/users/me/feed", authMiddleware, followHandler.GetFeed)
+}
@@ -0,0 +1,29 @@
+package api
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/handler"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/middleware"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// NewNotificationRouter registers the notification center routes and returns the publisher the
+// other routers hand to their services
+func NewNotificationRouter(app *fiber.App, db *gorm.DB, jwtSecret string, sessionService service.SessionService, live service.LiveEventPublisher) service.NotificationPublisher {
+	// Dependencies Injections for Notifications
+	notificationRepo := repository.NewNotificationRepository(db)
+	notificationService := service.NewNotificationService(notificationRepo)
+	notificationHandler := handler.NewNotificationHandler(notificationService)
+
+	notifications := app.Group("/users/me/notifications", middleware.AuthMiddleware(jwtSecret, sessionService))
+
+	notifications.Get("/", notificationHandler.ListNotifications)
+	notifications.Get("/unread-count", notificationHandler.GetUnreadCount)
+	notifications.Patch("/read-all", notificationHandler.MarkAllRead)
+	notifications.Patch("/:id/read", notificationHandler.MarkRead)
+
+	return service.NewNotificationPublisher(notificationRepo, repository.NewDBRoleRepository(db),
+		repository.NewOrganizationFollowRepository(db), live)
+}
@@ -5,30 +5,33 @@ import (
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/infrastructure"
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/middleware"
 	"github.com/casbin/casbin/v2"
 	"github.com/gofiber/fiber/v2"
 	"github.com/opensearch-project/opensearch-go"
 	"gorm.io/gorm"
 )
 
-func NewOrganizationRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, es *opensearch.Client, s3 *infrastructure.S3Uploader) {
+func NewOrganizationRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, es *opensearch.Client, s3 *infrastructure.S3Uploader, jwtSecret string, sessionService service.SessionService) {
 	// Dependencies Injections for Organization
 	organizationRepo := repository.NewOrganizationRepository(db)
 	casbinRoleRepository := repository.NewCasbinRoleRepository(enforcer)
-	organizationService := service.NewOrganizationService(organizationRepo, casbinRoleRepository, s3)
+	organizationService := service.NewOrganizationService(organizationRepo, casbinRoleRepository, db, es, s3, nil)
 	organizationHandler := handler.NewOrganizationHandler(organizationService)
 
 	//rbac
-	//authMiddleware := middleware.AuthMiddleware(jwtSecret)
+	//authMiddleware := middleware.AuthMiddleware(jwtSecret, sessionService)
 	//rbac := middleware.NewRBACMiddleware(enforcer)
 	//enforceMiddlewareWithOrganization := rbac.EnforceMiddlewareWithResources("Organization")
 
 	org := app.Group("/orgs")
 
 	app.Get("/orgs-paginate", organizationHandler.GetOrganizationPaginate)
+	app.Get("/orgs-paginate/search", organizationHandler.SearchOrganizations)
+	app.Get("/sync-orgs", organizationHandler.SyncOrganizations)
 	org.Get("/industries/list", organizationHandler.ListIndustries)
 	org.Get("/list", organizationHandler.ListOrganizations)
-	org.Get("/get/:orgID", organizationHandler.GetOrganizationByID)
+	org.Get("/get/:orgID", organizationHandler.GetPublicOrganizationByID)
 
 	//org.Post("/create", authMiddleware, organizationHandler.CreateOrganization)
 	//org.Patch("/:orgID/status", authMiddleware, enforceMiddlewareWithOrganization("update"), organizationHandler.UpdateOrganizationStatus)
@@ -54,12 +57,15 @@ func NewOrganizationRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforce
 	jobPreqRepo := repository.NewPrerequisiteRepository(db)
 	opensearchRepo := repository.NewOpenSearchRepository(es)
 	orgOpenJobService := service.NewOrgOpenJobService(orgOpenJobRepo, organizationRepo, jobPreqRepo, opensearchRepo, db, es, s3)
-	orgOpenJobHandler := handler.NewOrgOpenJobHandler(orgOpenJobService)
+	bookmarkRepo := repository.NewBookmarkRepository(db)
+	bookmarkService := service.NewBookmarkService(bookmarkRepo)
+	orgOpenJobHandler := handler.NewOrgOpenJobHandler(orgOpenJobService, bookmarkService)
+	optionalAuthMiddleware := middleware.OptionalAuthMiddleware(jwtSecret, sessionService)
 	//enforceMiddlewareWithOpenJob := rbac.EnforceMiddlewareWithResources("OrganizationOpenJob")
 
 	// Define routes for Organization Open Jobs
 	org.Get("/jobs/list/all", orgOpenJobHandler.ListAllOrganizationJobs)
-	org.Get("/jobs/jobs-paginate", orgOpenJobHandler.GetPaginateOrgOpenJob)
+	org.Get("/jobs/jobs-paginate", optionalAuthMiddleware, orgOpenJobHandler.GetPaginateOrgOpenJob)
 
 	org.Get("/:orgID/jobs/list", orgOpenJobHandler.ListOrgOpenJobsByOrgID)
 	org.Get("/:orgID/jobs/get/:id", orgOpenJobHandler.GetOrgOpenJobByIDwithOrgID)
@@ -69,13 +75,13 @@ func NewOrganizationRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforce
 	//org.Delete("/:orgID/jobs/delete/:id", authMiddleware, enforceMiddlewareWithOpenJob("delete"), orgOpenJobHandler.DeleteOrgOpenJob)
 
 	// Searching Jobs
-	app.Get("/jobs-paginate/search", orgOpenJobHandler.SearchJobs)
+	app.Get("/jobs-paginate/search", optionalAuthMiddleware, orgOpenJobHandler.SearchJobs)
 	// Sync PostGres to OpenSearch
 	app.Get("/sync-orgs-jobs", orgOpenJobHandler.SyncJobs)
 
 	// Get job for frontend
 	app.Get("/jobs/get/:id", orgOpenJobHandler.GetJobByID)
-	app.Get("/orgs/:id", organizationHandler.GetOrganizationByID)
+	app.Get("/orgs/:id", organizationHandler.GetPublicOrganizationByID)
 
 	// Pre-requisite
 	//org.Post("/:orgID/jobs/:jobID/prerequisites", authMiddleware, enforceMiddlewareWithOpenJob("create"), orgOpenJobHandler.CreatePrerequisite)
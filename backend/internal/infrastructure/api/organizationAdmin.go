@@ -6,35 +6,64 @@ import (
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
 	"github.com/DAF-Bridge/asaiasa-Backend/middleware"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
 	"github.com/casbin/casbin/v2"
 	"github.com/gofiber/fiber/v2"
 	"github.com/opensearch-project/opensearch-go"
 	"gorm.io/gorm"
 )
 
-func NewOrganizationAdminRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, es *opensearch.Client, s3 *infrastructure.S3Uploader, jwtSecret string) {
+func NewOrganizationAdminRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, es *opensearch.Client, s3 *infrastructure.S3Uploader, jwtSecret string, sessionService service.SessionService,
+	notifications service.NotificationPublisher) {
 	// Dependencies Injections for Organization
 	organizationRepo := repository.NewOrganizationRepository(db)
 	casbinRoleRepository := repository.NewCasbinRoleRepository(enforcer)
-	organizationService := service.NewOrganizationService(organizationRepo, casbinRoleRepository, s3)
+	organizationService := service.NewOrganizationService(organizationRepo, casbinRoleRepository, db, es, s3, notifications)
 	organizationHandler := handler.NewOrganizationHandler(organizationService)
 
+	// Dependencies Injections for Organization Contact
+	orgContactRepo := repository.NewOrganizationContactRepository(db)
+	orgContactService := service.NewOrganizationContactService(orgContactRepo)
+	orgContactHandler := handler.NewOrganizationContactHandler(orgContactService)
+
+	// Dependencies Injections for Organization Open Jobs
+	orgOpenJobRepo := repository.NewOrgOpenJobRepository(db)
+	jobPreqRepo := repository.NewPrerequisiteRepository(db)
+	opensearchRepo := repository.NewOpenSearchRepository(es)
+	orgOpenJobService := service.NewOrgOpenJobService(orgOpenJobRepo, organizationRepo, jobPreqRepo, opensearchRepo, db, es, s3)
+	orgOpenJobHandler := handler.NewOrgOpenJobHandler(orgOpenJobService, nil)
+
+	// Audit log, the snapshots let it record what each change did
+	audit := middleware.NewAuditMiddleware(repository.NewAuditLogRepository(db)).
+		Snapshot("Organization", func(c *fiber.Ctx, orgID uint) (interface{}, error) {
+			return organizationService.GetOrganizationByID(orgID)
+		}).
+		Snapshot("OrganizationContact", func(c *fiber.Ctx, orgID uint) (interface{}, error) {
+			id, err := utils.GetParamFormFiberCtx(c, "id", "contact")
+			if err != nil {
+				return nil, err
+			}
+			return orgContactService.GetContactByID(orgID, id)
+		}).
+		Snapshot("OrganizationOpenJob", func(c *fiber.Ctx, orgID uint) (interface{}, error) {
+			id, err := utils.GetParamFormFiberCtx(c, "id", "job")
+			if err != nil {
+				return nil, err
+			}
+			return orgOpenJobService.GetManagedJobByID(orgID, id)
+		})
+
 	//rbac
-	rbac := middleware.NewRBACMiddleware(enforcer)
+	rbac := middleware.NewRBACMiddleware(enforcer).WithAudit(audit)
 	enforceMiddlewareWithOrganization := rbac.EnforceMiddlewareWithResources("Organization")
 
-	org := app.Group("/admin/orgs", middleware.AuthMiddleware(jwtSecret))
+	org := app.Group("/admin/orgs", middleware.AuthMiddleware(jwtSecret, sessionService))
 	org.Post("/create", organizationHandler.CreateOrganization)
 	org.Get("/get/:orgID", enforceMiddlewareWithOrganization("read"), organizationHandler.GetOrganizationByID)
 	org.Patch("/:orgID/status", enforceMiddlewareWithOrganization("update"), organizationHandler.UpdateOrganizationStatus)
 	org.Put("/update/:orgID", enforceMiddlewareWithOrganization("update"), organizationHandler.UpdateOrganization)
 	org.Delete("/delete/:orgID", enforceMiddlewareWithOrganization("delete"), organizationHandler.DeleteOrganization)
 
-	// Dependencies Injections for Organization Contact
-	orgContactRepo := repository.NewOrganizationContactRepository(db)
-	orgContactService := service.NewOrganizationContactService(orgContactRepo)
-	orgContactHandler := handler.NewOrganizationContactHandler(orgContactService)
-
 	// Define routes for Organization Contact
 	enforceMiddlewareWithContact := rbac.EnforceMiddlewareWithResources("OrganizationContact")
 
@@ -44,17 +73,11 @@ func NewOrganizationAdminRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEn
 	org.Get("/:orgID/contacts/get/:id", enforceMiddlewareWithContact("read"), orgContactHandler.GetContactByID)
 	org.Get("/:orgID/contacts/list", enforceMiddlewareWithContact("read"), orgContactHandler.GetAllContactsByOrgID)
 
-	// Dependencies Injections for Organization Open Jobs
-	orgOpenJobRepo := repository.NewOrgOpenJobRepository(db)
-	jobPreqRepo := repository.NewPrerequisiteRepository(db)
-	opensearchRepo := repository.NewOpenSearchRepository(es)
-	orgOpenJobService := service.NewOrgOpenJobService(orgOpenJobRepo, organizationRepo, jobPreqRepo, opensearchRepo, db, es, s3)
-	orgOpenJobHandler := handler.NewOrgOpenJobHandler(orgOpenJobService)
 	enforceMiddlewareWithOpenJob := rbac.EnforceMiddlewareWithResources("OrganizationOpenJob")
 
 	// Define routes for Organization Open Jobs
-	org.Get("/:orgID/jobs/list", enforceMiddlewareWithOpenJob("read"), orgOpenJobHandler.ListOrgOpenJobsByOrgID)
-	org.Get("/:orgID/jobs/get/:id", enforceMiddlewareWithOpenJob("read"), orgOpenJobHandler.GetOrgOpenJobByIDwithOrgID)
+	org.Get("/:orgID/jobs/list", enforceMiddlewareWithOpenJob("read"), orgOpenJobHandler.ListManagedJobsByOrgID)
+	org.Get("/:orgID/jobs/get/:id", enforceMiddlewareWithOpenJob("read"), orgOpenJobHandler.GetManagedJobByID)
 	org.Get("/:orgID/jobs/count", enforceMiddlewareWithOpenJob("read"), orgOpenJobHandler.GetNumberOfJobs)
 	org.Post("/:orgID/jobs/create", enforceMiddlewareWithOpenJob("create"), orgOpenJobHandler.CreateOrgOpenJob)
 	org.Put("/:orgID/jobs/update/:id", enforceMiddlewareWithOpenJob("update"), orgOpenJobHandler.UpdateOrgOpenJob)
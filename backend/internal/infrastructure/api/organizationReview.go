@@ -0,0 +1,34 @@
+package api
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/handler"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/middleware"
+	"github.com/casbin/casbin/v2"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+func NewOrganizationReviewRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, jwtSecret string, sessionService service.SessionService,
+	notifications service.NotificationPublisher) {
+	// Dependencies Injections for Organization Reviews
+	reviewRepo := repository.NewOrganizationReviewRepository(db)
+	orgRepo := repository.NewOrganizationRepository(db)
+	roleRepo := repository.NewDBRoleRepository(db)
+	reviewService := service.NewOrganizationReviewService(reviewRepo, orgRepo, roleRepo, notifications)
+	reviewHandler := handler.NewOrganizationReviewHandler(reviewService)
+
+	authMiddleware := middleware.AuthMiddleware(jwtSecret, sessionService)
+	rbac := middleware.NewRBACMiddleware(enforcer)
+
+	// System admin review queue
+	review := app.Group("/sysadmin/orgs")
+	review.Get("/", authMiddleware, rbac.EnforceGlobal("Organization", "read"), reviewHandler.ListOrganizations)
+	review.Post("/:orgID/approve", authMiddleware, rbac.EnforceGlobal("Organization", "review"), reviewHandler.Approve)
+	review.Post("/:orgID/reject", authMiddleware, rbac.EnforceGlobal("Organization", "review"), reviewHandler.Reject)
+	review.Post("/:orgID/request-changes", authMiddleware, rbac.EnforceGlobal("Organization", "review"), reviewHandler.RequestChanges)
+
+	// Organization members
+	app.Get("/admin/orgs/:orgID/reviews", authMiddleware, rbac.EnforceMiddleware("Organization", "read"), reviewHandler.ListReviews)
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/infrastructure/recommendation"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
 	"github.com/DAF-Bridge/asaiasa-Backend/middleware"
 	"github.com/DAF-Bridge/asaiasa-Backend/utils"
 	"github.com/gofiber/fiber/v2"
@@ -9,8 +10,8 @@ import (
 	"gorm.io/gorm"
 )
 
-func NewRecommendationRouter(app *fiber.App, db *gorm.DB, jwtSecret string) {
-	app.Get("/recommendation", middleware.AuthMiddleware(jwtSecret), func(c *fiber.Ctx) error {
+func NewRecommendationRouter(app *fiber.App, db *gorm.DB, jwtSecret string, sessionService service.SessionService) {
+	app.Get("/recommendation", middleware.AuthMiddleware(jwtSecret, sessionService), func(c *fiber.Ctx) error {
 		user, err := utils.ExtractJWTClaims(c)
 		if err != nil {
 			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": err.Error()})
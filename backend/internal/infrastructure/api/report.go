@@ -0,0 +1,35 @@
+package api
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/handler"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/middleware"
+	"github.com/casbin/casbin/v2"
+	"github.com/gofiber/fiber/v2"
+	"github.com/opensearch-project/opensearch-go"
+	"gorm.io/gorm"
+)
+
+func NewReportRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, es *opensearch.Client, jwtSecret string, sessionService service.SessionService,
+	notifications service.NotificationPublisher) {
+	// Dependencies Injections for Reports and Moderation
+	reportRepo := repository.NewReportRepository(db)
+	opensearchRepo := repository.NewOpenSearchRepository(es)
+	reportHandler := handler.NewReportHandler(service.NewReportService(reportRepo))
+	moderationHandler := handler.NewModerationHandler(service.NewModerationService(reportRepo, opensearchRepo, notifications))
+
+	authMiddleware := middleware.AuthMiddleware(jwtSecret, sessionService)
+	rbac := middleware.NewRBACMiddleware(enforcer)
+
+	app.Post("/reports", authMiddleware, reportHandler.CreateReport)
+
+	reports := app.Group("/sysadmin/reports")
+
+	reports.Get("/", authMiddleware, rbac.EnforceGlobal("Report", "read"), moderationHandler.ListReports)
+	reports.Get("/:id", authMiddleware, rbac.EnforceGlobal("Report", "read"), moderationHandler.GetReport)
+	reports.Post("/:id/dismiss", authMiddleware, rbac.EnforceGlobal("Report", "moderate"), moderationHandler.Dismiss)
+	reports.Post("/:id/hide", authMiddleware, rbac.EnforceGlobal("Report", "moderate"), moderationHandler.Hide)
+	reports.Post("/:id/suspend-organization", authMiddleware, rbac.EnforceGlobal("Report", "moderate"), moderationHandler.SuspendOrganization)
+	reports.Post("/:id/reinstate", authMiddleware, rbac.EnforceGlobal("Report", "moderate"), moderationHandler.Reinstate)
+}
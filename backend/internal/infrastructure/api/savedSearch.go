@@ -0,0 +1,37 @@
+package api
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/middleware"
+	"html/template"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/handler"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/gofiber/fiber/v2"
+	"github.com/opensearch-project/opensearch-go"
+	"gopkg.in/gomail.v2"
+	"gorm.io/gorm"
+)
+
+// NewSavedSearchRouter registers the saved search routes and returns the service whose digests
+// the app schedules
+func NewSavedSearchRouter(app *fiber.App, db *gorm.DB, es *opensearch.Client, mail *gomail.Dialer, digestTemplate *template.Template,
+	baseWebURL string, baseUnsubscribeURL string, jwtSecret string, sessionService service.SessionService) service.SavedSearchService {
+	// Dependencies Injections for Saved Searches
+	savedSearchRepo := repository.NewSavedSearchRepository(db)
+	digestMailRepo := repository.NewDigestMailRepository(mail, digestTemplate, baseWebURL, baseUnsubscribeURL)
+	savedSearchService := service.NewSavedSearchService(savedSearchRepo, es, digestMailRepo)
+	savedSearchHandler := handler.NewSavedSearchHandler(savedSearchService)
+
+	savedSearches := app.Group("/users/me/saved-searches", middleware.AuthMiddleware(jwtSecret, sessionService))
+
+	savedSearches.Get("/", savedSearchHandler.ListSavedSearches)
+	savedSearches.Post("/", savedSearchHandler.CreateSavedSearch)
+	savedSearches.Get("/:id", savedSearchHandler.GetSavedSearch)
+	savedSearches.Put("/:id", savedSearchHandler.UpdateSavedSearch)
+	savedSearches.Delete("/:id", savedSearchHandler.DeleteSavedSearch)
+
+	app.Post("/saved-searches/unsubscribe", savedSearchHandler.Unsubscribe)
+
+	return savedSearchService
+}
@@ -0,0 +1,26 @@
+package api
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/handler"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/infrastructure/stream"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/middleware"
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	// streamClientBuffer is how many events a stream may fall behind before it is dropped
+	streamClientBuffer = 64
+	// streamHistorySize is how many recent events, over all users, are kept for replay
+	streamHistorySize = 4096
+)
+
+// NewStreamRouter registers /users/me/stream and returns the hub the other routers push events to
+func NewStreamRouter(app *fiber.App, jwtSecret string, sessionService service.SessionService) *stream.Hub {
+	hub := stream.NewHub(streamClientBuffer, streamHistorySize)
+	streamHandler := handler.NewStreamHandler(hub, sessionService)
+
+	app.Get("/users/me/stream", middleware.AuthMiddleware(jwtSecret, sessionService), streamHandler.Stream)
+
+	return hub
+}
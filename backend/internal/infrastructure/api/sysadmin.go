@@ -0,0 +1,47 @@
+package api
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/handler"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/middleware"
+	"github.com/casbin/casbin/v2"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+func NewSystemAdminRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, jwtSecret string, sessionService service.SessionService) {
+	// Dependencies Injections for the System Admin console
+	userRepo := repository.NewUserRepository(db)
+	casbinRoleRepository := repository.NewCasbinRoleRepository(enforcer)
+	categoryRepo := repository.NewCategoryRepository(db)
+	industryRepo := repository.NewIndustryRepository(db)
+	statsRepo := repository.NewPlatformStatsRepository(db)
+	sysAdminService := service.NewSystemAdminService(userRepo, casbinRoleRepository, categoryRepo, industryRepo, statsRepo, sessionService)
+	sysAdminHandler := handler.NewSystemAdminHandler(sysAdminService)
+
+	authMiddleware := middleware.AuthMiddleware(jwtSecret, sessionService)
+	rbac := middleware.NewRBACMiddleware(enforcer)
+
+	// Organization moderation lives under /sysadmin/orgs, see NewOrganizationReviewRouter
+	sysadmin := app.Group("/sysadmin")
+
+	sysadmin.Get("/users", authMiddleware, rbac.EnforceGlobal("User", "read"), sysAdminHandler.SearchUsers)
+	sysadmin.Post("/users/:id/suspend", authMiddleware, rbac.EnforceGlobal("User", "suspend"), sysAdminHandler.SuspendUser)
+	sysadmin.Post("/users/:id/reinstate", authMiddleware, rbac.EnforceGlobal("User", "suspend"), sysAdminHandler.ReinstateUser)
+	sysadmin.Post("/users/:id/logout", authMiddleware, rbac.EnforceGlobal("User", "logout"), sysAdminHandler.ForceLogout)
+	sysadmin.Put("/users/:id/system-admin", authMiddleware, rbac.EnforceGlobal("User", "grant"), sysAdminHandler.GrantSystemAdmin)
+	sysadmin.Delete("/users/:id/system-admin", authMiddleware, rbac.EnforceGlobal("User", "grant"), sysAdminHandler.RevokeSystemAdmin)
+
+	sysadmin.Get("/categories", authMiddleware, rbac.EnforceGlobal("Category", "read"), sysAdminHandler.ListCategories)
+	sysadmin.Post("/categories", authMiddleware, rbac.EnforceGlobal("Category", "create"), sysAdminHandler.CreateCategory)
+	sysadmin.Put("/categories/:id", authMiddleware, rbac.EnforceGlobal("Category", "update"), sysAdminHandler.UpdateCategory)
+	sysadmin.Delete("/categories/:id", authMiddleware, rbac.EnforceGlobal("Category", "delete"), sysAdminHandler.DeleteCategory)
+
+	sysadmin.Get("/industries", authMiddleware, rbac.EnforceGlobal("Industry", "read"), sysAdminHandler.ListIndustries)
+	sysadmin.Post("/industries", authMiddleware, rbac.EnforceGlobal("Industry", "create"), sysAdminHandler.CreateIndustry)
+	sysadmin.Put("/industries/:id", authMiddleware, rbac.EnforceGlobal("Industry", "update"), sysAdminHandler.UpdateIndustry)
+	sysadmin.Delete("/industries/:id", authMiddleware, rbac.EnforceGlobal("Industry", "delete"), sysAdminHandler.DeleteIndustry)
+
+	sysadmin.Get("/stats", authMiddleware, rbac.EnforceGlobal("Stats", "read"), sysAdminHandler.GetPlatformStats)
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/handler"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/middleware"
+	"github.com/casbin/casbin/v2"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+func NewTicketRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, jwtSecret string, sessionService service.SessionService, qrSecret string,
+	live service.LiveEventPublisher) {
+	// Dependencies Injections for Ticket
+	ticketRepo := repository.NewTicketAvailableRepository(db)
+	purchaseRepo := repository.NewTicketPurchasedRepository(db)
+	eventRepo := repository.NewEventRepository(db)
+	userRepo := repository.NewUserRepository(db)
+	roleRepo := repository.NewDBRoleRepository(db)
+	ticketService := service.NewTicketAvailableService(ticketRepo, eventRepo)
+	purchaseService := service.NewTicketPurchasedService(purchaseRepo, ticketRepo, eventRepo, userRepo, roleRepo, qrSecret, live)
+	ticketHandler := handler.NewTicketHandler(ticketService, purchaseService)
+
+	authMiddleware := middleware.AuthMiddleware(jwtSecret, sessionService)
+	rbac := middleware.NewRBACMiddleware(enforcer).WithAudit(middleware.NewAuditMiddleware(repository.NewAuditLogRepository(db)))
+
+	// Public
+	app.Get("/events/:id/tickets", ticketHandler.ListTicketsByEventID)
+
+	// Buyer
+	app.Post("/events/:id/tickets/:ticketID/purchase", authMiddleware, ticketHandler.PurchaseTicket)
+	app.Get("/users/me/tickets", authMiddleware, ticketHandler.ListMyTickets)
+	app.Get("/users/me/tickets/:id", authMiddleware, ticketHandler.GetMyTicket)
+
+	// Organization admin
+	ticket := app.Group("admin/orgs/:orgID/events/:id/tickets", authMiddleware)
+	ticket.Get("/", rbac.EnforceMiddleware("Event", "read"), ticketHandler.ListOrgTicketsByEventID)
+	ticket.Post("/", rbac.EnforceMiddleware("Event", "update"), ticketHandler.CreateTicket)
+	ticket.Put("/:ticketID", rbac.EnforceMiddleware("Event", "update"), ticketHandler.UpdateTicket)
+	ticket.Delete("/:ticketID", rbac.EnforceMiddleware("Event", "update"), ticketHandler.DeleteTicket)
+
+	// Door check-in
+	checkIn := app.Group("admin/orgs/:orgID/events/:id/check-in", authMiddleware)
+	checkIn.Post("/", rbac.EnforceMiddleware("Event", "update"), ticketHandler.CheckIn)
+	checkIn.Get("/attendance", rbac.EnforceMiddleware("Event", "read"), ticketHandler.GetAttendance)
+}
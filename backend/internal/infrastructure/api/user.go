@@ -6,24 +6,38 @@ import (
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
 	"github.com/DAF-Bridge/asaiasa-Backend/middleware"
+	"github.com/casbin/casbin/v2"
 	"github.com/gofiber/fiber/v2"
 	"gorm.io/gorm"
 )
 
-func NewUserRouter(app *fiber.App, db *gorm.DB, s3 *infrastructure.S3Uploader, jwtSecret string) {
+func NewUserRouter(app *fiber.App, db *gorm.DB, enforcer casbin.IEnforcer, s3 *infrastructure.S3Uploader, jwtSecret string, sessionService service.SessionService) {
 	// Dependencies Injections for User
 	userRepo := repository.NewUserRepository(db)
 	userService := service.NewUserService(userRepo, s3)
 	userHandler := handler.NewUserHandler(userService)
 
+	rbac := middleware.NewRBACMiddleware(enforcer)
+	authMiddleware := middleware.AuthMiddleware(jwtSecret, sessionService)
+
 	user := app.Group("/users")
 
 	user.Post("/", userHandler.CreateUser)
-	user.Get("/", userHandler.ListUsers)
-	user.Post("/upload-profile", middleware.AuthMiddleware(jwtSecret), userHandler.UploadProfilePicture)
+	user.Get("/", authMiddleware, rbac.EnforceGlobal("User", "read"), userHandler.ListUsers)
+	user.Post("/upload-profile", authMiddleware, userHandler.UploadProfilePicture)
+
+	app.Get("/current-user-profile", authMiddleware, userHandler.GetCurrentUser)
+	app.Put("/update-profile", authMiddleware, userHandler.UpdateProfile)
+
+	// Dependencies Injections for Public Profiles
+	profileVisibilityRepo := repository.NewProfileVisibilityRepository(db)
+	eventParticipantRepo := repository.NewEventParticipantRepository(db)
+	publicProfileService := service.NewPublicProfileService(userRepo, profileVisibilityRepo, eventParticipantRepo)
+	publicProfileHandler := handler.NewPublicProfileHandler(publicProfileService)
 
-	app.Get("/current-user-profile", middleware.AuthMiddleware(jwtSecret), userHandler.GetCurrentUser)
-	app.Put("/update-profile", middleware.AuthMiddleware(jwtSecret), userHandler.UpdateProfile)
+	user.Get("/:id/profile", publicProfileHandler.GetPublicProfile)
+	app.Get("/users/me/profile-visibility", authMiddleware, publicProfileHandler.GetProfileVisibility)
+	app.Put("/users/me/profile-visibility", authMiddleware, publicProfileHandler.UpdateProfileVisibility)
 
 	// Dependencies Injections for User Preference
 	userPreferenceRepo := repository.NewUserPreferenceRepository(db)
@@ -33,29 +47,29 @@ func NewUserRouter(app *fiber.App, db *gorm.DB, s3 *infrastructure.S3Uploader, j
 
 	app.Get("/users/user-preference/list", userPreferenceHandler.ListUserPreferences)
 	app.Get("/users/event-preference/list", userPreferenceHandler.ListEventTrainingPreference)
-	app.Post("/users/user-preference", middleware.AuthMiddleware(jwtSecret), userPreferenceHandler.CreateUserPreference)
-	app.Get("/users/user-preference", middleware.AuthMiddleware(jwtSecret), userPreferenceHandler.GetUserPreferenceByUserID)
-	app.Put("/users/user-preference", middleware.AuthMiddleware(jwtSecret), userPreferenceHandler.UpdateUserPreference)
-	app.Delete("/users/user-preference", middleware.AuthMiddleware(jwtSecret), userPreferenceHandler.DeleteUserPreference)
+	app.Post("/users/user-preference", authMiddleware, userPreferenceHandler.CreateUserPreference)
+	app.Get("/users/user-preference", authMiddleware, userPreferenceHandler.GetUserPreferenceByUserID)
+	app.Put("/users/user-preference", authMiddleware, userPreferenceHandler.UpdateUserPreference)
+	app.Delete("/users/user-preference", authMiddleware, userPreferenceHandler.DeleteUserPreference)
 
 	//userInteractRepository := repository.NewUserInteractRepository(db)
 	//userInteractService := service.NewUserInteractService(userInteractRepository)
 	//userInteractHandler := handler.NewUserInteractHandler(userInteractService)
 	//
 	//app.Get("/users/interact/list", userInteractHandler.GetAllUserInteract)
-	//app.Get("/users/interact", middleware.AuthMiddleware(jwtSecret), userInteractHandler.GetUserInteractByUserID)
+	//app.Get("/users/interact", authMiddleware, userInteractHandler.GetUserInteractByUserID)
 	//app.Get("/users/interact/category/:categoryID", userInteractHandler.GetUserInteractByCategoryID)
-	//app.Post("/users/interact/events/:eventID", middleware.AuthMiddleware(jwtSecret), userInteractHandler.InterestedInTheEvent)
+	//app.Post("/users/interact/events/:eventID", authMiddleware, userInteractHandler.InterestedInTheEvent)
 	//
 	//
 	userInteractEventRepository := repository.NewUserInteractEventRepository(db)
 	userInteractEventService := service.NewUserInteractEventService(userInteractEventRepository)
 	userInteractEventHandler := handler.NewUserInteractEventHandler(userInteractEventService)
 
-	app.Post("/users/interact/events/:eventID", middleware.AuthMiddleware(jwtSecret), userInteractEventHandler.InterestedInTheEvent)
+	app.Post("/users/interact/events/:eventID", authMiddleware, userInteractEventHandler.InterestedInTheEvent)
 	app.Get("/users/interact/events/list", userInteractEventHandler.GetAllUserInteractEvent)
-	app.Get("/users/interact/events", middleware.AuthMiddleware(jwtSecret), userInteractEventHandler.GetUserInteractEventsByUserID)
+	app.Get("/users/interact/events", authMiddleware, userInteractEventHandler.GetUserInteractEventsByUserID)
 	app.Get("/users/interact/categories/list", userInteractEventHandler.GetAllStatUserInteractCategories)
 	app.Get("/interact/events/", userInteractEventHandler.GetAllInteractedEventPerUser)
-	app.Get("/interact/categories/", middleware.AuthMiddleware(jwtSecret), userInteractEventHandler.GetStatUserInteractCategoriesByUserID)
+	app.Get("/interact/categories/", authMiddleware, userInteractEventHandler.GetStatUserInteractCategoriesByUserID)
 }
@@ -0,0 +1,27 @@
+package api
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/handler"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/middleware"
+	"github.com/DAF-Bridge/asaiasa-Backend/pkg/oauthprovider"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+func NewUserIdentityRouter(app *fiber.App, db *gorm.DB, providers oauthprovider.Registry, jwtSecret string, sessionService service.SessionService) {
+	// Dependencies Injections for User Identities
+	identityRepo := repository.NewUserIdentityRepository(db)
+	userRepo := repository.NewUserRepository(db)
+	identityService := service.NewUserIdentityService(identityRepo, userRepo)
+	identityHandler := handler.NewUserIdentityHandler(identityService, providers)
+
+	identities := app.Group("/users/me/identities", middleware.AuthMiddleware(jwtSecret, sessionService))
+
+	identities.Get("/", identityHandler.ListLoginMethods)
+	identities.Post("/password", identityHandler.LinkPassword)
+	identities.Delete("/password", identityHandler.UnlinkPassword)
+	identities.Post("/:provider", identityHandler.LinkProvider)
+	identities.Delete("/:provider", identityHandler.UnlinkProvider)
+}
@@ -8,6 +8,7 @@ import (
 	"mime/multipart"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/DAF-Bridge/asaiasa-Backend/logs"
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -164,6 +165,59 @@ func (s *S3Uploader) UploadJobBanner(ctx context.Context, file multipart.File, f
 	return fileURL, nil
 }
 
+// UploadApplicationResume stores a resume privately and returns its object key.
+// Unlike pictures it is never public; use PresignGetURL to hand out a download link.
+func (s *S3Uploader) UploadApplicationResume(ctx context.Context, file multipart.File, fileHeader *multipart.FileHeader, jobID uint, userID uuid.UUID) (string, error) {
+	fileExt := filepath.Ext(fileHeader.Filename)
+	objectKey := fmt.Sprintf("applications/jobs/%v/%s/%s%s", jobID, userID, uuid.New(), fileExt)
+
+	buffer := bytes.NewBuffer(nil)
+	if _, err := buffer.ReadFrom(file); err != nil {
+		logs.Error(err)
+		return "", fmt.Errorf("failed to read file: %w", err)
+	}
+
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucketName),
+		Key:         aws.String(objectKey),
+		Body:        buffer,
+		ContentType: aws.String(fileHeader.Header.Get("Content-Type")),
+	})
+	if err != nil {
+		logs.Error(err)
+		return "", fmt.Errorf("failed to upload file: %w", err)
+	}
+
+	logs.Info(fmt.Sprintf("Resume uploaded successfully. Key: %s", objectKey))
+	return objectKey, nil
+}
+
+// DeleteObject removes a private object, such as a resume whose application could not be saved
+func (s *S3Uploader) DeleteObject(ctx context.Context, objectKey string) error {
+	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(objectKey),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+
+	return nil
+}
+
+// PresignGetURL returns a link that grants read access to a private object until it expires
+func (s *S3Uploader) PresignGetURL(ctx context.Context, objectKey string, expires time.Duration) (string, error) {
+	request, err := s3.NewPresignClient(s.client).PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucketName),
+		Key:    aws.String(objectKey),
+	}, s3.WithPresignExpires(expires))
+	if err != nil {
+		return "", fmt.Errorf("failed to presign object: %w", err)
+	}
+
+	return request.URL, nil
+}
+
 func sendObject(ctx context.Context, client *s3.Client, bucketName string, objectKey string, buffer *bytes.Buffer) error {
 	_, err := client.PutObject(ctx, &s3.PutObjectInput{
 		Bucket: aws.String(bucketName),
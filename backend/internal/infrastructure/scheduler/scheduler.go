@@ -0,0 +1,38 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/logs"
+)
+
+// Every calls job each interval until ctx is cancelled. A failing or panicking run is logged
+// and the next one still happens.
+func Every(ctx context.Context, interval time.Duration, name string, job func(now time.Time) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	logs.Info(fmt.Sprintf("Scheduled %s every %s", name, interval))
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			run(name, now, job)
+		}
+	}
+}
+
+func run(name string, now time.Time, job func(now time.Time) error) {
+	defer func() {
+		if r := recover(); r != nil {
+			logs.Error(fmt.Sprintf("Scheduled %s panicked: %v", name, r))
+		}
+	}()
+
+	if err := job(now); err != nil {
+		logs.Error(fmt.Sprintf("Scheduled %s failed: %v", name, err))
+	}
+}
@@ -1 +1,149 @@
 package search
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/asaiasa-Backend/logs"
+	"github.com/opensearch-project/opensearch-go"
+)
+
+func SearchOrganizations(client *opensearch.Client, query dto.SearchOrganizationQuery, page int, offset int) (dto.SearchOrganizationResponse, error) {
+	query.Page = page
+	query.Offset = offset
+	searchQuery := buildSearchOrganizationQuery(query)
+
+	queryBody, err := json.Marshal(searchQuery)
+	if err != nil {
+		logs.Error(fmt.Sprintf("failed to marshal search query: %v", err))
+		return dto.SearchOrganizationResponse{}, err
+	}
+
+	res, err := client.Search(
+		client.Search.WithIndex("organizations"),
+		client.Search.WithBody(bytes.NewReader(queryBody)),
+		client.Search.WithContext(context.Background()),
+	)
+	if err != nil {
+		logs.Error(fmt.Sprintf("failed to execute search on: %v", err))
+		return dto.SearchOrganizationResponse{}, err
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		logs.Error(fmt.Sprintf("search on organizations returned %s", res.Status()))
+		return dto.SearchOrganizationResponse{}, fmt.Errorf("search on organizations returned %s", res.Status())
+	}
+
+	var result map[string]interface{}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		logs.Error(fmt.Sprintf("failed to decode search response: %v", err))
+		return dto.SearchOrganizationResponse{}, err
+	}
+
+	hitsWrapper, ok := result["hits"].(map[string]interface{})
+	if !ok {
+		logs.Error("No search results found")
+		return dto.SearchOrganizationResponse{}, nil
+	}
+
+	hits, ok := hitsWrapper["hits"].([]interface{})
+	if !ok || len(hits) == 0 {
+		return dto.SearchOrganizationResponse{
+			TotalOrganization: 0,
+			Organizations:     []dto.OrganizationDocument{},
+		}, nil
+	}
+
+	var results []dto.OrganizationDocument
+	for _, hit := range hits {
+		source := hit.(map[string]interface{})["_source"].(map[string]interface{})
+		org := dto.OrganizationDocument{}
+		jsonString, _ := json.Marshal(source)
+		json.Unmarshal(jsonString, &org)
+		results = append(results, org)
+	}
+
+	totalHits := hitsWrapper["total"].(map[string]interface{})["value"].(float64)
+
+	return dto.SearchOrganizationResponse{
+		TotalOrganization: int(totalHits),
+		Organizations:     results,
+	}, nil
+}
+
+func buildSearchOrganizationQuery(query dto.SearchOrganizationQuery) map[string]interface{} {
+	searchQuery := make(map[string]interface{})
+	boolQuery := make(map[string]interface{})
+	var must []map[string]interface{}
+	var filter []map[string]interface{}
+
+	if query.Q != "" {
+		must = append(must, map[string]interface{}{
+			"multi_match": map[string]interface{}{
+				"query":                query.Q,
+				"fields":               []string{"name^3", "headline^2", "description", "industries", "province", "country"},
+				"fuzziness":            "AUTO",
+				"operator":             "or",
+				"fuzzy_transpositions": true,
+			},
+		})
+	} else {
+		must = append(must, map[string]interface{}{
+			"match_all": map[string]interface{}{},
+		})
+	}
+
+	if query.Industry != "" {
+		filter = append(filter, map[string]interface{}{
+			"terms": map[string]interface{}{
+				"industries.keyword": strings.Split(query.Industry, ","),
+			},
+		})
+	}
+	if query.Province != "" {
+		must = append(must, map[string]interface{}{
+			"match": map[string]interface{}{
+				"province": query.Province,
+			},
+		})
+	}
+	if query.Country != "" {
+		must = append(must, map[string]interface{}{
+			"match": map[string]interface{}{
+				"country": query.Country,
+			},
+		})
+	}
+	if query.Status != "" {
+		filter = append(filter, map[string]interface{}{
+			"term": map[string]interface{}{
+				"status.keyword": query.Status,
+			},
+		})
+	}
+
+	if query.Page != 0 {
+		searchQuery["from"] = (query.Page - 1) * query.Offset
+	}
+	if query.Offset != 0 {
+		searchQuery["size"] = query.Offset
+	}
+
+	boolQuery["must"] = must
+	if len(filter) > 0 {
+		boolQuery["filter"] = filter
+	}
+	searchQuery["query"] = map[string]interface{}{
+		"bool": boolQuery,
+	}
+
+	// keyword-less listings score 1.0 from match_all, so the threshold only prunes weak fuzzy matches
+	searchQuery["min_score"] = 0.65
+
+	return searchQuery
+}
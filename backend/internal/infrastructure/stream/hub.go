@@ -0,0 +1,150 @@
+package stream
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/logs"
+	"github.com/google/uuid"
+)
+
+// Event is one message on the stream of a user
+type Event struct {
+	ID     uint64
+	Name   string
+	Data   []byte
+	userID uuid.UUID
+}
+
+// Client is one open stream. Events arrive on Events until Dropped is closed, which happens when
+// the client falls more than its buffer behind. A dropped client reconnects and catches up
+// through the replay history.
+type Client struct {
+	userID  uuid.UUID
+	events  chan Event
+	dropped chan struct{}
+}
+
+func (c *Client) Events() <-chan Event {
+	return c.events
+}
+
+func (c *Client) Dropped() <-chan struct{} {
+	return c.dropped
+}
+
+// Hub fans events out to the open streams of each user and keeps the last events of every user
+// in one shared ring so reconnecting clients can replay what they missed.
+type Hub struct {
+	mu           sync.Mutex
+	nextID       uint64
+	clients      map[uuid.UUID]map[*Client]struct{}
+	clientBuffer int
+	history      []Event
+	historyHead  int
+	historyLen   int
+}
+
+func NewHub(clientBuffer int, historySize int) *Hub {
+	return &Hub{
+		// Starting from the boot time keeps IDs increasing across restarts, so a Last-Event-ID from
+		// before a restart replays everything kept since
+		nextID:       uint64(time.Now().UnixMicro()),
+		clients:      map[uuid.UUID]map[*Client]struct{}{},
+		clientBuffer: clientBuffer,
+		history:      make([]Event, historySize),
+	}
+}
+
+// Subscribe opens a stream for the user. When lastEventID is not zero it also returns the kept
+// events of the user newer than it, which the caller must send before reading from the client.
+func (h *Hub) Subscribe(userID uuid.UUID, lastEventID uint64) (*Client, []Event) {
+	client := &Client{
+		userID:  userID,
+		events:  make(chan Event, h.clientBuffer),
+		dropped: make(chan struct{}),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var missed []Event
+	if lastEventID != 0 {
+		for i := 0; i < h.historyLen; i++ {
+			event := h.history[(h.historyHead+i)%len(h.history)]
+			if event.userID == userID && event.ID > lastEventID {
+				missed = append(missed, event)
+			}
+		}
+	}
+
+	if h.clients[userID] == nil {
+		h.clients[userID] = map[*Client]struct{}{}
+	}
+	h.clients[userID][client] = struct{}{}
+
+	return client, missed
+}
+
+func (h *Hub) Unsubscribe(client *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.remove(client)
+}
+
+// SendToUsers pushes an event with data encoded as JSON to every open stream of the users
+func (h *Hub) SendToUsers(userIDs []uuid.UUID, name string, data interface{}) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		logs.Error("Failed to encode " + name + " stream event: " + err.Error())
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, userID := range userIDs {
+		h.nextID++
+		event := Event{ID: h.nextID, Name: name, Data: payload, userID: userID}
+		h.remember(event)
+
+		for client := range h.clients[userID] {
+			select {
+			case client.events <- event:
+			default:
+				h.remove(client)
+				close(client.dropped)
+			}
+		}
+	}
+}
+
+func (h *Hub) remember(event Event) {
+	if len(h.history) == 0 {
+		return
+	}
+
+	if h.historyLen < len(h.history) {
+		h.history[(h.historyHead+h.historyLen)%len(h.history)] = event
+		h.historyLen++
+		return
+	}
+
+	h.history[h.historyHead] = event
+	h.historyHead = (h.historyHead + 1) % len(h.history)
+}
+
+// remove must be called with h.mu held
+func (h *Hub) remove(client *Client) {
+	clients := h.clients[client.userID]
+	if _, ok := clients[client]; !ok {
+		return
+	}
+
+	delete(clients, client)
+	if len(clients) == 0 {
+		delete(h.clients, client.userID)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
 
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
 	"github.com/DAF-Bridge/asaiasa-Backend/logs"
 	"github.com/opensearch-project/opensearch-go"
 	"gorm.io/gorm"
@@ -15,7 +16,7 @@ import (
 
 func SyncEventsToOpenSearch(db *gorm.DB, client *opensearch.Client) error {
 	var events []models.Event
-	if err := db.Preload("Organization").Preload("Categories").Find(&events).Error; err != nil {
+	if err := db.Scopes(repository.VisibleContent("events")).Preload("Organization").Preload("Categories").Find(&events).Error; err != nil {
 		return fmt.Errorf("failed to fetch events: %v", err)
 	}
 
@@ -83,7 +84,7 @@ func SyncJobsToOpenSearch(db *gorm.DB, client *opensearch.Client) error {
 	}
 
 	var jobs []models.OrgOpenJob
-	if err := db.Preload("Organization").Preload("Prerequisites").Preload("Categories").Find(&jobs).Error; err != nil {
+	if err := db.Scopes(repository.VisibleContent("org_open_jobs")).Preload("Organization").Preload("Prerequisites").Preload("Categories").Find(&jobs).Error; err != nil {
 		return fmt.Errorf("failed to fetch jobs: %v", err)
 	}
 
@@ -140,6 +141,50 @@ func SyncJobsToOpenSearch(db *gorm.DB, client *opensearch.Client) error {
 	return nil
 }
 
+func SyncOrganizationsToOpenSearch(db *gorm.DB, client *opensearch.Client) error {
+	var orgs []models.Organization
+	if err := db.Preload("Industries").Scopes(repository.VisibleOrganizations).Find(&orgs).Error; err != nil {
+		return fmt.Errorf("failed to fetch organizations: %v", err)
+	}
+
+	for _, org := range orgs {
+		var industries []string
+		for _, industry := range org.Industries {
+			industries = append(industries, industry.Industry)
+		}
+
+		doc := dto.OrganizationDocument{
+			ID:          org.ID,
+			Name:        org.Name,
+			PicUrl:      org.PicUrl,
+			HeadLine:    org.HeadLine,
+			Description: org.Description,
+			Latitude:    org.Latitude,
+			Longitude:   org.Longitude,
+			Province:    org.Province,
+			Country:     org.Country,
+			Email:       org.Email,
+			Phone:       org.Phone,
+			Industries:  industries,
+			Status:      org.Status,
+			UpdateAt:    org.UpdatedAt.Format("2006-01-02 15:04:05"),
+		}
+
+		jsonData, _ := json.Marshal(doc)
+		req := bytes.NewReader(jsonData)
+
+		res, err := client.Index("organizations", req, client.Index.WithDocumentID(fmt.Sprintf("%d", org.ID)))
+		if err != nil {
+			logs.Error(fmt.Sprintf("Error indexing organization %d: %v", org.ID, err))
+			continue
+		}
+		res.Body.Close()
+		logs.Info(fmt.Sprintf("Indexed organization %d", org.ID))
+	}
+
+	return nil
+}
+
 func ensureJobIndexExists(client *opensearch.Client) error {
 	res, err := client.Indices.Exists([]string{"jobs"})
 	if err != nil {
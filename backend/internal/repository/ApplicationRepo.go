@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type applicationRepository struct {
+	db *gorm.DB
+}
+
+func NewApplicationRepository(db *gorm.DB) models.ApplicationRepository {
+	return applicationRepository{db: db}
+}
+
+func (r applicationRepository) Create(application *models.Application) error {
+	return r.db.Create(application).Error
+}
+
+func (r applicationRepository) GetByID(id uint) (*models.Application, error) {
+	var application models.Application
+	if err := r.preloaded().Where("id = ?", id).First(&application).Error; err != nil {
+		return nil, err
+	}
+
+	return &application, nil
+}
+
+func (r applicationRepository) GetByIDAndJobID(jobID uint, id uint) (*models.Application, error) {
+	var application models.Application
+	if err := r.preloaded().Where("id = ? AND job_id = ?", id, jobID).First(&application).Error; err != nil {
+		return nil, err
+	}
+
+	return &application, nil
+}
+
+func (r applicationRepository) ListByUserID(userID uuid.UUID) ([]models.Application, error) {
+	var applications []models.Application
+	err := r.db.
+		Preload("Job").
+		Preload("Job.Organization").
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&applications).Error
+	return applications, err
+}
+
+func (r applicationRepository) ListByJobID(jobID uint, status models.ApplicationStatus) ([]models.Application, error) {
+	var applications []models.Application
+	query := r.db.
+		Preload("Job").
+		Preload("Job.Organization").
+		Preload("User").
+		Where("job_id = ?", jobID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	err := query.Order("created_at ASC").Find(&applications).Error
+	return applications, err
+}
+
+func (r applicationRepository) UpdateStatus(change *models.ApplicationStatusChange) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.Application{}).
+			Where("id = ? AND status = ?", change.ApplicationID, change.FromStatus).
+			Update("status", change.ToStatus)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return models.ErrApplicationStatusChanged
+		}
+
+		return tx.Create(change).Error
+	})
+}
+
+func (r applicationRepository) preloaded() *gorm.DB {
+	return r.db.
+		Preload("Job").
+		Preload("Job.Organization").
+		Preload("User").
+		Preload("StatusHistory", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC")
+		})
+}
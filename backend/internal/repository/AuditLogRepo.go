@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"gorm.io/gorm"
+)
+
+type auditLogRepository struct {
+	db *gorm.DB
+}
+
+func NewAuditLogRepository(db *gorm.DB) models.AuditLogRepository {
+	return auditLogRepository{db: db}
+}
+
+func (r auditLogRepository) Create(log *models.AuditLog) error {
+	return r.db.Create(log).Error
+}
+
+func (r auditLogRepository) ListByOrganization(orgID uint, filter models.AuditLogFilter, page int, size int) ([]models.AuditLog, int64, error) {
+	query := r.db.Model(&models.AuditLog{}).Where("organization_id = ?", orgID)
+	if filter.ActorID != nil {
+		query = query.Where("actor_id = ?", *filter.ActorID)
+	}
+	if filter.Resource != "" {
+		query = query.Where("resource = ?", filter.Resource)
+	}
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at < ?", *filter.To)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var logs []models.AuditLog
+	err := query.Order("created_at DESC, id DESC").
+		Offset((page - 1) * size).
+		Limit(size).
+		Find(&logs).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return logs, total, nil
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type bookmarkRepository struct {
+	db *gorm.DB
+}
+
+func NewBookmarkRepository(db *gorm.DB) models.BookmarkRepository {
+	return bookmarkRepository{db: db}
+}
+
+func (r bookmarkRepository) Create(bookmark *models.Bookmark) error {
+	return r.db.Create(bookmark).Error
+}
+
+func (r bookmarkRepository) Delete(userID uuid.UUID, targetType models.BookmarkTargetType, targetID uint) error {
+	result := r.db.Where("user_id = ? AND target_type = ? AND target_id = ?", userID, targetType, targetID).
+		Delete(&models.Bookmark{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r bookmarkRepository) ListByUserID(userID uuid.UUID, targetType models.BookmarkTargetType) ([]models.Bookmark, error) {
+	query := r.db.Where("user_id = ?", userID)
+	if targetType != "" {
+		query = query.Where("target_type = ?", targetType)
+	}
+
+	var bookmarks []models.Bookmark
+	if err := query.Order("created_at DESC").Find(&bookmarks).Error; err != nil {
+		return nil, err
+	}
+
+	return bookmarks, nil
+}
+
+func (r bookmarkRepository) FilterBookmarked(userID uuid.UUID, targetType models.BookmarkTargetType, targetIDs []uint) ([]uint, error) {
+	if len(targetIDs) == 0 {
+		return nil, nil
+	}
+
+	var bookmarked []uint
+	err := r.db.Model(&models.Bookmark{}).
+		Where("user_id = ? AND target_type = ? AND target_id IN ?", userID, targetType, targetIDs).
+		Pluck("target_id", &bookmarked).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return bookmarked, nil
+}
+
+func (r bookmarkRepository) TargetExists(targetType models.BookmarkTargetType, targetID uint) (bool, error) {
+	var query *gorm.DB
+	switch targetType {
+	case models.BookmarkTargetEvent:
+		query = r.db.Model(&models.Event{}).Scopes(VisibleContent("events"))
+	case models.BookmarkTargetJob:
+		query = r.db.Model(&models.OrgOpenJob{}).Scopes(VisibleContent("org_open_jobs"))
+	case models.BookmarkTargetOrganization:
+		query = r.db.Model(&models.Organization{}).Scopes(VisibleOrganizations)
+	default:
+		return false, nil
+	}
+
+	var count int64
+	if err := query.Where("id = ?", targetID).Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r bookmarkRepository) GetEventsByIDs(ids []uint) ([]models.Event, error) {
+	var events []models.Event
+	if err := r.db.Scopes(VisibleContent("events")).Preload("Organization").Preload("Categories").Where("id IN ?", ids).Find(&events).Error; err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (r bookmarkRepository) GetJobsByIDs(ids []uint) ([]models.OrgOpenJob, error) {
+	var jobs []models.OrgOpenJob
+	if err := r.db.Scopes(VisibleContent("org_open_jobs")).Preload("Organization").Preload("Categories").Where("id IN ?", ids).Find(&jobs).Error; err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+func (r bookmarkRepository) GetOrganizationsByIDs(ids []uint) ([]models.Organization, error) {
+	var organizations []models.Organization
+	if err := r.db.Scopes(VisibleOrganizations).Where("id IN ?", ids).Find(&organizations).Error; err != nil {
+		return nil, err
+	}
+
+	return organizations, nil
+}
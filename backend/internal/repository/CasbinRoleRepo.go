@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"github.com/DAF-Bridge/asaiasa-Backend/pkg/authorization"
 	"github.com/casbin/casbin/v2"
 )
 
@@ -152,14 +153,22 @@ func (c CasbinRoleRepository) GetDomainsByUser(user string) []string {
 	return domains
 }
 
-func (c CasbinRoleRepository) ClearAllGrouping() (bool, error) {
+func (c CasbinRoleRepository) ClearOrganizationGrouping() (bool, error) {
 	//if err := c.enforcer.LoadPolicy(); err != nil {
 	//	return false, err
 	//}
-	groupingPolicy, err := c.enforcer.GetGroupingPolicy()
+	policies, err := c.enforcer.GetGroupingPolicy()
 	if err != nil {
 		return false, err
 	}
+	// Global grants are not stored in the roles table, so they must survive a rebuild from it
+	groupingPolicy := make([][]string, 0, len(policies))
+	for _, policy := range policies {
+		if len(policy) > 2 && policy[2] == authorization.GlobalDomain {
+			continue
+		}
+		groupingPolicy = append(groupingPolicy, policy)
+	}
 	if len(groupingPolicy) == 0 {
 		return true, nil
 	}
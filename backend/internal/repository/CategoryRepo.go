@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type categoryRepository struct {
+	db *gorm.DB
+}
+
+func NewCategoryRepository(db *gorm.DB) models.CategoryRepository {
+	return categoryRepository{db: db}
+}
+
+func (r categoryRepository) List() ([]models.Category, error) {
+	var categories []models.Category
+	if err := r.db.Order("sort_order ASC, id ASC").Find(&categories).Error; err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+func (r categoryRepository) GetByID(id uint) (*models.Category, error) {
+	var category models.Category
+	if err := r.db.First(&category, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &category, nil
+}
+
+func (r categoryRepository) Create(category *models.Category) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(category).Error; err != nil {
+			return err
+		}
+
+		// Create skips a false IsActive in favor of the column default
+		if !category.IsActive {
+			return tx.Model(category).Update("is_active", false).Error
+		}
+
+		return nil
+	})
+}
+
+func (r categoryRepository) Update(category *models.Category) error {
+	return r.db.Omit(clause.Associations).Save(category).Error
+}
+
+func (r categoryRepository) Delete(id uint) error {
+	return utils.GormErrorAndRowsAffected(r.db.Delete(&models.Category{}, id))
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type eventParticipantRepository struct {
+	db *gorm.DB
+}
+
+func NewEventParticipantRepository(db *gorm.DB) models.EventParticipantRepository {
+	return eventParticipantRepository{db: db}
+}
+
+func (r eventParticipantRepository) Register(participant *models.EventParticipant) error {
+	tx := r.db.Begin()
+
+	// Lock the event so concurrent registrations are counted one at a time
+	var event models.Event
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id", "capacity").
+		Where("id = ?", participant.EventId).
+		First(&event).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if event.Capacity > 0 {
+		var count int64
+		if err := tx.Model(&models.EventParticipant{}).Where("event_id = ?", participant.EventId).Count(&count).Error; err != nil {
+			tx.Rollback()
+			return err
+		}
+		if count >= int64(event.Capacity) {
+			tx.Rollback()
+			return models.ErrEventFull
+		}
+	}
+
+	if err := tx.Create(participant).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (r eventParticipantRepository) Unregister(userID uuid.UUID, eventID uint) error {
+	// Hard delete so the user can register again later
+	result := r.db.Unscoped().
+		Where("user_id = ? AND event_id = ?", userID, eventID).
+		Delete(&models.EventParticipant{})
+	return utils.GormErrorAndRowsAffected(result)
+}
+
+func (r eventParticipantRepository) UpdateVisibility(userID uuid.UUID, eventID uint, isVisible bool) error {
+	result := r.db.Model(&models.EventParticipant{}).
+		Where("user_id = ? AND event_id = ?", userID, eventID).
+		Update("is_visible", isVisible)
+	return utils.GormErrorAndRowsAffected(result)
+}
+
+func (r eventParticipantRepository) GetByUserIDAndEventID(userID uuid.UUID, eventID uint) (*models.EventParticipant, error) {
+	var participant models.EventParticipant
+	err := r.db.
+		Where("user_id = ? AND event_id = ?", userID, eventID).
+		First(&participant).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &participant, nil
+}
+
+func (r eventParticipantRepository) GetAllByEventID(eventID uint) ([]models.EventParticipant, error) {
+	var participants []models.EventParticipant
+	err := r.db.
+		Preload("User").
+		Where("event_id = ?", eventID).
+		Order("created_at ASC").
+		Find(&participants).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return participants, nil
+}
+
+func (r eventParticipantRepository) GetVisibleByEventID(eventID uint) ([]models.EventParticipant, error) {
+	var participants []models.EventParticipant
+	err := r.db.
+		Preload("User").
+		Where("event_id = ? AND is_visible = ?", eventID, true).
+		Order("created_at ASC").
+		Find(&participants).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return participants, nil
+}
+
+func (r eventParticipantRepository) GetVisibleByUserID(userID uuid.UUID) ([]models.EventParticipant, error) {
+	var participants []models.EventParticipant
+	err := r.db.
+		Joins("JOIN events ON events.id = event_participants.event_id AND events.deleted_at IS NULL").
+		Scopes(VisibleContent("events")).
+		Preload("Event").
+		Preload("Event.Organization").
+		Where("event_participants.user_id = ? AND event_participants.is_visible = ?", userID, true).
+		Order("events.start_date DESC").
+		Find(&participants).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return participants, nil
+}
+
+func (r eventParticipantRepository) CountByEventID(eventID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.EventParticipant{}).
+		Where("event_id = ?", eventID).
+		Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
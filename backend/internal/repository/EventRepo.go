@@ -39,6 +39,7 @@ func (r eventRepository) GetAll() ([]models.Event, error) {
 		Preload("ContactChannels").
 		Preload("Categories").
 		Preload("Organization").
+		Scopes(VisibleContent("events")).
 		Find(&events).Error
 	if err != nil {
 		return nil, err
@@ -125,6 +126,7 @@ func (r eventRepository) GetPaginate(page uint, size uint) ([]models.Event, erro
 	err := r.db.Preload("Organization").
 		Preload("Categories").
 		Preload("ContactChannels").
+		Scopes(VisibleContent("events")).
 		Order("created_at desc").
 		Limit(int(size)).
 		Offset(offset).
@@ -197,7 +199,8 @@ func (r eventRepository) Update(orgID uint, eventID uint, event *models.Event) (
 		}
 	}
 
-	if err := tx.Model(&existingEvent).Save(event).Error; err != nil {
+	// hidden_at is only set by moderation, an edit from the organization must not clear it
+	if err := tx.Model(&existingEvent).Omit("hidden_at").Save(event).Error; err != nil {
 		tx.Rollback()
 		return nil, err
 	}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type industryRepository struct {
+	db *gorm.DB
+}
+
+func NewIndustryRepository(db *gorm.DB) models.IndustryRepository {
+	return industryRepository{db: db}
+}
+
+func (r industryRepository) List() ([]models.Industry, error) {
+	var industries []models.Industry
+	if err := r.db.Order("id ASC").Find(&industries).Error; err != nil {
+		return nil, err
+	}
+
+	return industries, nil
+}
+
+func (r industryRepository) GetByID(id uint) (*models.Industry, error) {
+	var industry models.Industry
+	if err := r.db.First(&industry, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &industry, nil
+}
+
+func (r industryRepository) Create(industry *models.Industry) error {
+	return r.db.Omit(clause.Associations).Create(industry).Error
+}
+
+func (r industryRepository) Update(industry *models.Industry) error {
+	return r.db.Omit(clause.Associations).Save(industry).Error
+}
+
+func (r industryRepository) Delete(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM organization_industry WHERE industry_id = ?", id).Error; err != nil {
+			return err
+		}
+
+		return utils.GormErrorAndRowsAffected(tx.Delete(&models.Industry{}, id))
+	})
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const notificationBatchSize = 500
+
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) models.NotificationRepository {
+	return notificationRepository{db: db}
+}
+
+func (r notificationRepository) CreateMany(notifications []models.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	return r.db.CreateInBatches(&notifications, notificationBatchSize).Error
+}
+
+func (r notificationRepository) ListByUserID(userID uuid.UUID, unreadOnly bool, page int, size int) ([]models.Notification, error) {
+	query := r.db.Where("user_id = ?", userID)
+	if unreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+
+	var notifications []models.Notification
+	err := query.Order("created_at DESC, id DESC").
+		Limit(size).
+		Offset((page - 1) * size).
+		Find(&notifications).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+func (r notificationRepository) CountUnread(userID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r notificationRepository) MarkRead(userID uuid.UUID, id uint) error {
+	var notification models.Notification
+	if err := r.db.Where("id = ? AND user_id = ?", id, userID).First(&notification).Error; err != nil {
+		return err
+	}
+	if notification.ReadAt != nil {
+		return nil
+	}
+
+	return r.db.Model(&notification).Update("read_at", time.Now()).Error
+}
+
+func (r notificationRepository) MarkAllRead(userID uuid.UUID) (int64, error) {
+	result := r.db.Model(&models.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Update("read_at", time.Now())
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type organizationFollowRepository struct {
+	db *gorm.DB
+}
+
+func NewOrganizationFollowRepository(db *gorm.DB) models.OrganizationFollowRepository {
+	return organizationFollowRepository{db: db}
+}
+
+func (r organizationFollowRepository) Follow(follow *models.OrganizationFollow) error {
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(follow).Error
+}
+
+func (r organizationFollowRepository) Unfollow(userID uuid.UUID, orgID uint) error {
+	result := r.db.Where("user_id = ? AND organization_id = ?", userID, orgID).Delete(&models.OrganizationFollow{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r organizationFollowRepository) ListFollowedOrganizations(userID uuid.UUID) ([]models.Organization, error) {
+	var orgs []models.Organization
+	err := r.db.Joins("JOIN organization_follows ON organization_follows.organization_id = organizations.id").
+		Where("organization_follows.user_id = ?", userID).
+		Order("organization_follows.created_at DESC").
+		Find(&orgs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return orgs, nil
+}
+
+func (r organizationFollowRepository) ListFollowerIDs(orgID uint) ([]uuid.UUID, error) {
+	var userIDs []uuid.UUID
+	err := r.db.Model(&models.OrganizationFollow{}).
+		Where("organization_id = ?", orgID).
+		Pluck("user_id", &userIDs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+func (r organizationFollowRepository) ListFeedEvents(userID uuid.UUID, cursor *models.FeedCursor, limit int) ([]models.Event, error) {
+	query := r.feedQuery(&models.Event{}, "events", models.FeedItemEvent, string(models.Published), userID, cursor)
+
+	var events []models.Event
+	err := query.Preload("Organization").
+		Preload("Categories").
+		Order("events.published_at DESC, events.id DESC").
+		Limit(limit).
+		Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (r organizationFollowRepository) ListFeedJobs(userID uuid.UUID, cursor *models.FeedCursor, limit int) ([]models.OrgOpenJob, error) {
+	query := r.feedQuery(&models.OrgOpenJob{}, "org_open_jobs", models.FeedItemJob, string(models.JobStatusPublished), userID, cursor)
+
+	var jobs []models.OrgOpenJob
+	err := query.Preload("Organization").
+		Preload("Categories").
+		Order("org_open_jobs.published_at DESC, org_open_jobs.id DESC").
+		Limit(limit).
+		Find(&jobs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+// feedQuery selects the published and visible rows of table that belong to organizations the
+// user follows and come after the cursor in feed order.
+func (r organizationFollowRepository) feedQuery(model interface{}, table string, kind string, publishedStatus string, userID uuid.UUID, cursor *models.FeedCursor) *gorm.DB {
+	query := r.db.Model(model).
+		Joins("JOIN organization_follows ON organization_follows.organization_id = "+table+".organization_id").
+		Where("organization_follows.user_id = ?", userID).
+		Where(table+".status = ? AND "+table+".published_at IS NOT NULL", publishedStatus).
+		Scopes(VisibleContent(table))
+
+	if cursor == nil {
+		return query
+	}
+
+	// Items published at the same instant are ordered by kind, then ID
+	switch {
+	case kind < cursor.Kind:
+		query = query.Where(table+".published_at <= ?", cursor.PublishedAt)
+	case kind == cursor.Kind:
+		query = query.Where("("+table+".published_at, "+table+".id) < (?, ?)", cursor.PublishedAt, cursor.ID)
+	default:
+		query = query.Where(table+".published_at < ?", cursor.PublishedAt)
+	}
+
+	return query
+}
@@ -82,6 +82,7 @@ func (r organizationRepository) GetOrgsPaginate(page uint, size uint) ([]models.
 	err := r.db.
 		Preload("OrganizationContacts").
 		Preload("Industries").
+		Where("status = ?", models.OrgStatusApproved).
 		Order("created_at desc").Limit(int(size)).
 		Offset(offset).
 		Find(&orgs).Error
@@ -98,6 +99,7 @@ func (r organizationRepository) GetAllOrganizations() ([]models.Organization, er
 	err := r.db.
 		Preload("OrganizationContacts").
 		Preload("Industries").
+		Where("status = ?", models.OrgStatusApproved).
 		Find(&orgs).Error
 	if err != nil {
 		return nil, err
@@ -233,6 +235,32 @@ func (r organizationRepository) DeleteOrganization(id uint) error {
 	return nil
 }
 
+func (r organizationRepository) CountFollowers(orgIDs []uint) (map[uint]int64, error) {
+	counts := make(map[uint]int64, len(orgIDs))
+	if len(orgIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		OrganizationID uint
+		Count          int64
+	}
+	err := r.db.Model(&models.OrganizationFollow{}).
+		Select("organization_id, COUNT(*) AS count").
+		Where("organization_id IN ?", orgIDs).
+		Group("organization_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.OrganizationID] = row.Count
+	}
+
+	return counts, nil
+}
+
 // --------------------------------------------------------------------------
 // OrganizationContact Repository
 // --------------------------------------------------------------------------
@@ -398,6 +426,7 @@ func (r orgOpenJobRepository) GetAllJobs() ([]models.OrgOpenJob, error) {
 		Preload("Organization").
 		Preload("Prerequisites").
 		Preload("Categories").
+		Scopes(VisibleContent("org_open_jobs")).
 		Find(&orgs).Error
 	if err != nil {
 		return nil, err
@@ -457,6 +486,7 @@ func (r orgOpenJobRepository) GetJobsPaginate(page uint, size uint) ([]models.Or
 	err := r.db.Preload("Organization").
 		Preload("Categories").
 		Preload("Prerequisites").
+		Scopes(VisibleContent("org_open_jobs")).
 		Order("created_at desc").
 		Limit(int(size)).
 		Offset(offset).
@@ -505,7 +535,8 @@ func (r orgOpenJobRepository) UpdateJob(job *models.OrgOpenJob) (*models.OrgOpen
 		}
 	}
 
-	if err := tx.Model(&existJob).Save(job).Error; err != nil {
+	// hidden_at is only set by moderation, an edit from the organization must not clear it
+	if err := tx.Model(&existJob).Omit("hidden_at").Save(job).Error; err != nil {
 		tx.Rollback()
 		return nil, err
 	}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"gorm.io/gorm"
+)
+
+type organizationReviewRepository struct {
+	db *gorm.DB
+}
+
+func NewOrganizationReviewRepository(db *gorm.DB) models.OrganizationReviewRepository {
+	return organizationReviewRepository{db: db}
+}
+
+func (r organizationReviewRepository) Create(review *models.OrganizationReview, fromStatus string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.Organization{}).
+			Where("id = ? AND status = ?", review.OrganizationID, fromStatus).
+			Update("status", review.Decision)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		// Events and jobs are only public once their organization is approved
+		if review.Decision == models.OrgStatusApproved {
+			if err := reindexOrganizationContent(tx, review.OrganizationID); err != nil {
+				return err
+			}
+		}
+
+		return tx.Create(review).Error
+	})
+}
+
+func (r organizationReviewRepository) ListOrganizationsByStatus(status string, page uint, size uint) ([]models.Organization, error) {
+	var orgs []models.Organization
+	offset := int((page - 1) * size)
+
+	err := r.db.
+		Preload("OrganizationContacts").
+		Preload("Industries").
+		Where("status = ?", status).
+		Order("updated_at ASC, id ASC").
+		Limit(int(size)).
+		Offset(offset).
+		Find(&orgs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return orgs, nil
+}
+
+func (r organizationReviewRepository) ListByOrganizationID(orgID uint) ([]models.OrganizationReview, error) {
+	var reviews []models.OrganizationReview
+	err := r.db.Where("organization_id = ?", orgID).
+		Order("created_at DESC").
+		Find(&reviews).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return reviews, nil
+}
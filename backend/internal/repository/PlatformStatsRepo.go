@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"gorm.io/gorm"
+)
+
+type platformStatsRepository struct {
+	db *gorm.DB
+}
+
+func NewPlatformStatsRepository(db *gorm.DB) models.PlatformStatsRepository {
+	return platformStatsRepository{db: db}
+}
+
+func (r platformStatsRepository) GetPlatformStats() (*models.PlatformStats, error) {
+	stats := &models.PlatformStats{}
+
+	if err := r.db.Model(&models.User{}).Count(&stats.Users).Error; err != nil {
+		return nil, err
+	}
+	if err := r.db.Model(&models.User{}).Where("suspended_at IS NOT NULL").Count(&stats.SuspendedUsers).Error; err != nil {
+		return nil, err
+	}
+	if err := r.db.Model(&models.User{}).Where("created_at >= ?", time.Now().AddDate(0, 0, -30)).Count(&stats.NewUsersLast30Days).Error; err != nil {
+		return nil, err
+	}
+	if err := r.db.Model(&models.Application{}).Count(&stats.Applications).Error; err != nil {
+		return nil, err
+	}
+	if err := r.db.Model(&models.TicketPurchased{}).Count(&stats.TicketsPurchased).Error; err != nil {
+		return nil, err
+	}
+
+	var err error
+	if stats.OrganizationsByStatus, err = r.countByStatus(&models.Organization{}); err != nil {
+		return nil, err
+	}
+	if stats.EventsByStatus, err = r.countByStatus(&models.Event{}); err != nil {
+		return nil, err
+	}
+	if stats.JobsByStatus, err = r.countByStatus(&models.OrgOpenJob{}); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func (r platformStatsRepository) countByStatus(model interface{}) (map[string]int64, error) {
+	var rows []struct {
+		Status string
+		Count  int64
+	}
+	err := r.db.Model(model).
+		Select("status, COUNT(*) AS count").
+		Group("status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+
+	return counts, nil
+}
@@ -31,6 +31,10 @@ func (r *ProfileRepository) GetByUserID(userID uuid.UUID) (*models.Profile, erro
 	return &profile, nil
 }
 
+// ExperienceOrder lists experiences chronologically; ongoing ones come last among
+// entries that started on the same day.
+const ExperienceOrder = "start_date ASC, end_date ASC NULLS LAST, created_at ASC"
+
 type ExperienceRepository struct {
 	db *gorm.DB
 }
@@ -41,13 +45,17 @@ func NewExperienceRepository(db *gorm.DB) *ExperienceRepository {
 
 func (r *ExperienceRepository) GetByUserID(userID uuid.UUID) ([]models.Experience, error) {
 	var experiences []models.Experience
-	err := r.db.Where("User_ID = ?", userID).Find(&experiences).Error
+	err := r.db.
+		Joins("JOIN profiles ON profiles.id = experiences.profile_id AND profiles.deleted_at IS NULL").
+		Where("profiles.user_id = ?", userID).
+		Order(ExperienceOrder).
+		Find(&experiences).Error
 	return experiences, err
 }
 
 func (r *ExperienceRepository) GetByID(experienceID uuid.UUID) (*models.Experience, error) {
 	var experience models.Experience
-	if err := r.db.Where("ID = ?", experienceID).First(&experience).Error; err != nil {
+	if err := r.db.Where("uuid = ?", experienceID).First(&experience).Error; err != nil {
 		return nil, err
 	}
 	return &experience, nil
@@ -62,7 +70,6 @@ func (r *ExperienceRepository) Update(experience *models.Experience) error {
 }
 
 func (r *ExperienceRepository) Delete(experienceID uuid.UUID) error {
-	result := r.db.Delete(&models.Experience{}, experienceID)
+	result := r.db.Where("uuid = ?", experienceID).Delete(&models.Experience{})
 	return utils.GormErrorAndRowsAffected(result)
-
 }
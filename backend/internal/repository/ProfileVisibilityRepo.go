@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type profileVisibilityRepository struct {
+	db *gorm.DB
+}
+
+func NewProfileVisibilityRepository(db *gorm.DB) models.ProfileVisibilityRepository {
+	return profileVisibilityRepository{db: db}
+}
+
+func (r profileVisibilityRepository) GetByUserID(userID uuid.UUID) (*models.ProfileVisibility, error) {
+	var visibility models.ProfileVisibility
+	if err := r.db.Where("user_id = ?", userID).First(&visibility).Error; err != nil {
+		return nil, err
+	}
+
+	return &visibility, nil
+}
+
+func (r profileVisibilityRepository) Upsert(visibility *models.ProfileVisibility) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		UpdateAll: true,
+	}).Create(visibility).Error
+}
@@ -0,0 +1,244 @@
+package repository
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type reportRepository struct {
+	db *gorm.DB
+}
+
+func NewReportRepository(db *gorm.DB) models.ReportRepository {
+	return reportRepository{db: db}
+}
+
+func (r reportRepository) Create(report *models.Report) error {
+	return r.db.Create(report).Error
+}
+
+func (r reportRepository) GetByID(id uint) (*models.Report, error) {
+	var report models.Report
+	if err := r.db.Preload("Decision").First(&report, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
+
+func (r reportRepository) List(status models.ReportStatus, targetType models.ReportTargetType, page int, size int) ([]models.Report, int64, error) {
+	query := r.db.Model(&models.Report{}).Where("status = ?", status)
+	if targetType != "" {
+		query = query.Where("target_type = ?", targetType)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var reports []models.Report
+	err := query.Preload("Decision").
+		Order("created_at ASC, id ASC").
+		Offset((page - 1) * size).
+		Limit(size).
+		Find(&reports).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return reports, total, nil
+}
+
+func (r reportRepository) ListDecisions(targetType models.ReportTargetType, targetID string) ([]models.ModerationDecision, error) {
+	var decisions []models.ModerationDecision
+	err := r.db.Where("target_type = ? AND target_id = ?", targetType, targetID).
+		Order("created_at DESC, id DESC").
+		Find(&decisions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return decisions, nil
+}
+
+func (r reportRepository) TargetExists(targetType models.ReportTargetType, targetID string) (bool, error) {
+	var query *gorm.DB
+	switch targetType {
+	case models.ReportTargetUser:
+		userID, err := uuid.Parse(targetID)
+		if err != nil {
+			return false, nil
+		}
+		query = r.db.Model(&models.User{}).Where("id = ?", userID)
+	default:
+		model, id, ok := contentTarget(targetType, targetID)
+		if !ok {
+			return false, nil
+		}
+		query = r.db.Model(model).Where("id = ?", id)
+	}
+
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r reportRepository) TargetOrganizationID(targetType models.ReportTargetType, targetID string) (uint, error) {
+	model, id, ok := contentTarget(targetType, targetID)
+	if !ok {
+		return 0, gorm.ErrRecordNotFound
+	}
+	if targetType == models.ReportTargetOrganization {
+		if err := r.db.Select("id").First(&models.Organization{}, id).Error; err != nil {
+			return 0, err
+		}
+		return id, nil
+	}
+
+	var target struct{ OrganizationID uint }
+	if err := r.db.Model(model).Select("organization_id").Where("id = ?", id).First(&target).Error; err != nil {
+		return 0, err
+	}
+
+	return target.OrganizationID, nil
+}
+
+func (r reportRepository) Dismiss(decision *models.ModerationDecision) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return resolveReports(tx, decision, models.ReportStatusDismissed)
+	})
+}
+
+func (r reportRepository) Hide(decision *models.ModerationDecision) error {
+	model, id, ok := contentTarget(decision.TargetType, decision.TargetID)
+	if !ok || decision.TargetType == models.ReportTargetOrganization {
+		return gorm.ErrRecordNotFound
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(model).Where("id = ?", id).Update("hidden_at", time.Now())
+		if err := utils.GormErrorAndRowsAffected(result); err != nil {
+			return err
+		}
+
+		return resolveReports(tx, decision, models.ReportStatusActioned)
+	})
+}
+
+func (r reportRepository) SuspendOrganization(decision *models.ModerationDecision) error {
+	if decision.OrganizationID == nil {
+		return gorm.ErrRecordNotFound
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var org models.Organization
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "status").
+			Where("id = ?", *decision.OrganizationID).First(&org).Error; err != nil {
+			return err
+		}
+
+		decision.OrgStatusBefore = org.Status
+		if org.Status == models.OrgStatusSuspended {
+			// Suspended again, the status to restore is the one before the first suspension
+			var before []string
+			err := tx.Model(&models.ModerationDecision{}).
+				Where("organization_id = ? AND action = ?", org.ID, models.ModerationSuspendOrg).
+				Order("id DESC").Limit(1).
+				Pluck("org_status_before", &before).Error
+			if err != nil {
+				return err
+			}
+			decision.OrgStatusBefore = ""
+			if len(before) > 0 {
+				decision.OrgStatusBefore = before[0]
+			}
+		}
+
+		result := tx.Model(&models.Organization{}).Where("id = ?", org.ID).Update("status", models.OrgStatusSuspended)
+		if err := utils.GormErrorAndRowsAffected(result); err != nil {
+			return err
+		}
+
+		return resolveReports(tx, decision, models.ReportStatusActioned)
+	})
+}
+
+func (r reportRepository) Unhide(decision *models.ModerationDecision) error {
+	model, id, ok := contentTarget(decision.TargetType, decision.TargetID)
+	if !ok || decision.TargetType == models.ReportTargetOrganization {
+		return gorm.ErrRecordNotFound
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(model).Where("id = ? AND hidden_at IS NOT NULL", id).Update("hidden_at", nil)
+		if err := utils.GormErrorAndRowsAffected(result); err != nil {
+			return err
+		}
+
+		return tx.Create(decision).Error
+	})
+}
+
+func (r reportRepository) ReinstateOrganization(decision *models.ModerationDecision, status string) error {
+	if decision.OrganizationID == nil {
+		return gorm.ErrRecordNotFound
+	}
+	orgID := *decision.OrganizationID
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.Organization{}).
+			Where("id = ? AND status = ?", orgID, models.OrgStatusSuspended).
+			Update("status", status)
+		if err := utils.GormErrorAndRowsAffected(result); err != nil {
+			return err
+		}
+
+		// The suspension removed the events and jobs from search
+		if status == models.OrgStatusApproved {
+			if err := reindexOrganizationContent(tx, orgID); err != nil {
+				return err
+			}
+		}
+
+		return tx.Create(decision).Error
+	})
+}
+
+// resolveReports records the decision and closes the open reports on its target with status
+func resolveReports(tx *gorm.DB, decision *models.ModerationDecision, status models.ReportStatus) error {
+	if err := tx.Create(decision).Error; err != nil {
+		return err
+	}
+
+	return tx.Model(&models.Report{}).
+		Where("target_type = ? AND target_id = ? AND status = ?", decision.TargetType, decision.TargetID, models.ReportStatusOpen).
+		Updates(map[string]interface{}{"status": status, "decision_id": decision.ID}).Error
+}
+
+// contentTarget returns the model and numeric ID of an event, job or organization target
+func contentTarget(targetType models.ReportTargetType, targetID string) (interface{}, uint, bool) {
+	id, err := strconv.ParseUint(targetID, 10, 64)
+	if err != nil || id == 0 {
+		return nil, 0, false
+	}
+
+	switch targetType {
+	case models.ReportTargetEvent:
+		return &models.Event{}, uint(id), true
+	case models.ReportTargetJob:
+		return &models.OrgOpenJob{}, uint(id), true
+	case models.ReportTargetOrganization:
+		return &models.Organization{}, uint(id), true
+	}
+	return nil, 0, false
+}
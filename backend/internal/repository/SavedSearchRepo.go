@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type savedSearchRepository struct {
+	db *gorm.DB
+}
+
+func NewSavedSearchRepository(db *gorm.DB) models.SavedSearchRepository {
+	return savedSearchRepository{db: db}
+}
+
+func (r savedSearchRepository) Create(savedSearch *models.SavedSearch) error {
+	return r.db.Create(savedSearch).Error
+}
+
+func (r savedSearchRepository) GetByIDAndUserID(id uint, userID uuid.UUID) (*models.SavedSearch, error) {
+	var savedSearch models.SavedSearch
+	if err := r.db.Where("id = ? AND user_id = ?", id, userID).First(&savedSearch).Error; err != nil {
+		return nil, err
+	}
+
+	return &savedSearch, nil
+}
+
+func (r savedSearchRepository) ListByUserID(userID uuid.UUID) ([]models.SavedSearch, error) {
+	var savedSearches []models.SavedSearch
+	if err := r.db.Where("user_id = ?", userID).Order("created_at DESC").Find(&savedSearches).Error; err != nil {
+		return nil, err
+	}
+
+	return savedSearches, nil
+}
+
+func (r savedSearchRepository) Update(savedSearch *models.SavedSearch) error {
+	return r.db.Omit(clause.Associations).Save(savedSearch).Error
+}
+
+func (r savedSearchRepository) Delete(id uint, userID uuid.UUID) error {
+	result := r.db.Where("id = ? AND user_id = ?", id, userID).Delete(&models.SavedSearch{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r savedSearchRepository) Unsubscribe(token string) error {
+	result := r.db.Model(&models.SavedSearch{}).
+		Where("unsubscribe_token = ?", token).
+		Update("subscribed", false)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r savedSearchRepository) ListDue(now time.Time, limit int) ([]models.SavedSearch, error) {
+	var savedSearches []models.SavedSearch
+	if err := r.db.Preload("User").
+		Where("subscribed = ? AND next_run_at <= ?", true, now).
+		Order("next_run_at ASC").
+		Limit(limit).
+		Find(&savedSearches).Error; err != nil {
+		return nil, err
+	}
+
+	return savedSearches, nil
+}
+
+func (r savedSearchRepository) Claim(id uint, nextRunAt time.Time, newNextRunAt time.Time, lastRunAt time.Time) error {
+	// Matching on the next run we read lets only one backend instance send each digest
+	result := r.db.Model(&models.SavedSearch{}).
+		Where("id = ? AND next_run_at = ?", id, nextRunAt).
+		Updates(map[string]interface{}{
+			"next_run_at": newNextRunAt,
+			"last_run_at": lastRunAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return models.ErrSavedSearchAlreadyClaimed
+	}
+
+	return nil
+}
+
+func (r savedSearchRepository) UnseenHits(savedSearchID uint, itemIDs []uint) ([]uint, error) {
+	var seenIDs []uint
+	err := r.db.Model(&models.SavedSearchHit{}).
+		Where("saved_search_id = ? AND item_id IN ?", savedSearchID, itemIDs).
+		Pluck("item_id", &seenIDs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uint]bool, len(seenIDs))
+	for _, id := range seenIDs {
+		seen[id] = true
+	}
+	unseenIDs := make([]uint, 0, len(itemIDs))
+	for _, id := range itemIDs {
+		if !seen[id] {
+			unseenIDs = append(unseenIDs, id)
+		}
+	}
+
+	return unseenIDs, nil
+}
+
+func (r savedSearchRepository) RecordHits(savedSearchID uint, itemIDs []uint) error {
+	hits := make([]models.SavedSearchHit, 0, len(itemIDs))
+	for _, itemID := range itemIDs {
+		hits = append(hits, models.SavedSearchHit{SavedSearchID: savedSearchID, ItemID: itemID})
+	}
+	if len(hits) == 0 {
+		return nil
+	}
+
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&hits).Error
+}
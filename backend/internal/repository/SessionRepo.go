@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type sessionRepository struct {
+	db *gorm.DB
+}
+
+func NewSessionRepository(db *gorm.DB) models.SessionRepository {
+	return sessionRepository{db: db}
+}
+
+func (r sessionRepository) Create(session *models.UserSession, refreshToken *models.RefreshToken) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(session).Error; err != nil {
+			return err
+		}
+
+		refreshToken.SessionID = session.ID
+		return tx.Create(refreshToken).Error
+	})
+}
+
+func (r sessionRepository) GetSessionByID(id uuid.UUID) (*models.UserSession, error) {
+	var session models.UserSession
+	if err := r.db.Preload("User").Where("id = ?", id).First(&session).Error; err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+func (r sessionRepository) GetRefreshTokenByHash(tokenHash string) (*models.RefreshToken, error) {
+	var refreshToken models.RefreshToken
+	if err := r.db.Preload("Session").Preload("Session.User").Where("token_hash = ?", tokenHash).First(&refreshToken).Error; err != nil {
+		return nil, err
+	}
+
+	return &refreshToken, nil
+}
+
+func (r sessionRepository) Rotate(oldTokenID uuid.UUID, newToken *models.RefreshToken) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// Only one caller can flip rotated_at, so two concurrent refreshes with the
+		// same token cannot both receive a successor.
+		result := tx.Model(&models.RefreshToken{}).
+			Where("id = ? AND rotated_at IS NULL", oldTokenID).
+			Update("rotated_at", time.Now())
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return models.ErrRefreshTokenReused
+		}
+
+		if err := tx.Create(newToken).Error; err != nil {
+			return err
+		}
+
+		// Each rotation extends the session, so active devices stay signed in
+		return tx.Model(&models.UserSession{}).
+			Where("id = ?", newToken.SessionID).
+			Update("expires_at", newToken.ExpiresAt).Error
+	})
+}
+
+func (r sessionRepository) RevokeSession(id uuid.UUID) error {
+	return r.db.Model(&models.UserSession{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", time.Now()).Error
+}
+
+func (r sessionRepository) RevokeAllByUserID(userID uuid.UUID) error {
+	return r.db.Model(&models.UserSession{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", time.Now()).Error
+}
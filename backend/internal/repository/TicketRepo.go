@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ticketAvailableRepository struct {
+	db *gorm.DB
+}
+
+func NewTicketAvailableRepository(db *gorm.DB) models.TicketAvailableRepository {
+	return ticketAvailableRepository{db: db}
+}
+
+func (r ticketAvailableRepository) GetByID(id uint) (*models.TicketAvailable, error) {
+	var ticket models.TicketAvailable
+	if err := r.db.Where("id = ?", id).First(&ticket).Error; err != nil {
+		return nil, err
+	}
+
+	return &ticket, nil
+}
+
+func (r ticketAvailableRepository) GetAll() ([]models.TicketAvailable, error) {
+	var tickets []models.TicketAvailable
+	if err := r.db.Find(&tickets).Error; err != nil {
+		return nil, err
+	}
+
+	return tickets, nil
+}
+
+func (r ticketAvailableRepository) GetAllByEventID(eventID uint) ([]models.TicketAvailable, error) {
+	var tickets []models.TicketAvailable
+	err := r.db.
+		Where("event_id = ?", eventID).
+		Order("price ASC").
+		Find(&tickets).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return tickets, nil
+}
+
+func (r ticketAvailableRepository) Create(ticketAvailable *models.TicketAvailable) error {
+	return r.db.Create(ticketAvailable).Error
+}
+
+func (r ticketAvailableRepository) Update(ticketAvailable *models.TicketAvailable) error {
+	result := r.db.
+		Model(&models.TicketAvailable{}).
+		Where("id = ? AND event_id = ?", ticketAvailable.ID, ticketAvailable.EventID).
+		Select("title", "description", "quantity", "price").
+		Updates(ticketAvailable)
+
+	return utils.GormErrorAndRowsAffected(result)
+}
+
+func (r ticketAvailableRepository) Delete(id uint) error {
+	result := r.db.Where("id = ?", id).Delete(&models.TicketAvailable{})
+	return utils.GormErrorAndRowsAffected(result)
+}
+
+type ticketPurchasedRepository struct {
+	db *gorm.DB
+}
+
+func NewTicketPurchasedRepository(db *gorm.DB) models.TicketPurchasedRepository {
+	return ticketPurchasedRepository{db: db}
+}
+
+func (r ticketPurchasedRepository) Purchase(purchase *models.TicketPurchased) error {
+	tx := r.db.Begin()
+
+	// The conditional update takes a row lock, so concurrent buyers are serialised
+	// and the quantity can never drop below zero.
+	result := tx.Model(&models.TicketAvailable{}).
+		Where("id = ? AND event_id = ? AND quantity > 0", purchase.TicketAvailableID, purchase.EventID).
+		UpdateColumn("quantity", gorm.Expr("quantity - ?", 1))
+	if result.Error != nil {
+		tx.Rollback()
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return models.ErrTicketSoldOut
+	}
+
+	if err := tx.Create(purchase).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+func (r ticketPurchasedRepository) GetByID(id uint) (*models.TicketPurchased, error) {
+	var purchase models.TicketPurchased
+	err := r.db.
+		Preload("Event").
+		Preload("Event.Organization").
+		Where("id = ?", id).
+		First(&purchase).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &purchase, nil
+}
+
+func (r ticketPurchasedRepository) GetByQrcode(qrcode string) (*models.TicketPurchased, error) {
+	var purchase models.TicketPurchased
+	if err := r.db.Preload("Event").Where("qrcode = ?", qrcode).First(&purchase).Error; err != nil {
+		return nil, err
+	}
+
+	return &purchase, nil
+}
+
+func (r ticketPurchasedRepository) CheckIn(eventID uint, qrcode string, confirmationAt string) error {
+	// Guarding on the empty confirmation makes a second scan a no-op even when two
+	// staff members scan the same code at once.
+	result := r.db.Model(&models.TicketPurchased{}).
+		Where("event_id = ? AND qrcode = ? AND (confirmation_at IS NULL OR confirmation_at = '')", eventID, qrcode).
+		Update("confirmation_at", confirmationAt)
+	return utils.GormErrorAndRowsAffected(result)
+}
+
+func (r ticketPurchasedRepository) CountByEventID(eventID uint) (int64, int64, error) {
+	var counts struct {
+		Total     int64
+		CheckedIn int64
+	}
+	err := r.db.Model(&models.TicketPurchased{}).
+		Select("COUNT(*) AS total, COUNT(*) FILTER (WHERE confirmation_at IS NOT NULL AND confirmation_at <> '') AS checked_in").
+		Where("event_id = ?", eventID).
+		Scan(&counts).Error
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return counts.Total, counts.CheckedIn, nil
+}
+
+func (r ticketPurchasedRepository) GetAllByUserID(userID uuid.UUID) ([]models.TicketPurchased, error) {
+	var purchases []models.TicketPurchased
+	err := r.db.
+		Preload("Event").
+		Preload("Event.Organization").
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&purchases).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return purchases, nil
+}
@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type userIdentityRepository struct {
+	db *gorm.DB
+}
+
+func NewUserIdentityRepository(db *gorm.DB) models.UserIdentityRepository {
+	return userIdentityRepository{db: db}
+}
+
+func (r userIdentityRepository) GetByProvider(provider models.Provider, providerID string) (*models.UserIdentity, error) {
+	var identity models.UserIdentity
+	if err := r.db.Preload("User").Where("provider = ? AND provider_id = ?", provider, providerID).First(&identity).Error; err != nil {
+		return nil, err
+	}
+
+	return &identity, nil
+}
+
+func (r userIdentityRepository) GetAllByUserID(userID uuid.UUID) ([]models.UserIdentity, error) {
+	var identities []models.UserIdentity
+	if err := r.db.Where("user_id = ?", userID).Order("created_at ASC").Find(&identities).Error; err != nil {
+		return nil, err
+	}
+
+	return identities, nil
+}
+
+func (r userIdentityRepository) Create(identity *models.UserIdentity) error {
+	return r.db.Create(identity).Error
+}
+
+func (r userIdentityRepository) CreateWithUser(user *models.User, profile *models.Profile, identity *models.UserIdentity) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(user).Error; err != nil {
+			return err
+		}
+
+		profile.UserID = user.ID
+		if err := tx.Create(profile).Error; err != nil {
+			return err
+		}
+
+		identity.UserID = user.ID
+		return tx.Create(identity).Error
+	})
+}
+
+func (r userIdentityRepository) Delete(userID uuid.UUID, provider models.Provider) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		user, err := lockUserWithLoginMethodCount(tx, userID)
+		if err != nil {
+			return err
+		}
+		if user.loginMethods <= 1 {
+			return models.ErrLastLoginMethod
+		}
+
+		result := tx.Where("user_id = ? AND provider = ?", userID, provider).Delete(&models.UserIdentity{})
+		return utils.GormErrorAndRowsAffected(result)
+	})
+}
+
+func (r userIdentityRepository) RemovePassword(userID uuid.UUID) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		user, err := lockUserWithLoginMethodCount(tx, userID)
+		if err != nil {
+			return err
+		}
+		if user.Password == nil {
+			return gorm.ErrRecordNotFound
+		}
+		if user.loginMethods <= 1 {
+			return models.ErrLastLoginMethod
+		}
+
+		return tx.Model(&models.User{}).Where("id = ?", userID).Update("password", nil).Error
+	})
+}
+
+func (r userIdentityRepository) SetPassword(userID uuid.UUID, hashedPassword string) error {
+	result := r.db.Model(&models.User{}).
+		Where("id = ? AND password IS NULL", userID).
+		Update("password", hashedPassword)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return models.ErrPasswordAlreadySet
+	}
+
+	return nil
+}
+
+type userWithLoginMethods struct {
+	models.User
+	loginMethods int64
+}
+
+// lockUserWithLoginMethodCount serialises changes to a user's login methods, so two
+// concurrent unlinks cannot both pass the last-method check.
+func lockUserWithLoginMethodCount(tx *gorm.DB, userID uuid.UUID) (*userWithLoginMethods, error) {
+	var user models.User
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", userID).First(&user).Error; err != nil {
+		return nil, err
+	}
+
+	var identities int64
+	if err := tx.Model(&models.UserIdentity{}).Where("user_id = ?", userID).Count(&identities).Error; err != nil {
+		return nil, err
+	}
+
+	loginMethods := identities
+	if user.Password != nil {
+		loginMethods++
+	}
+
+	return &userWithLoginMethods{User: user, loginMethods: loginMethods}, nil
+}
@@ -2,11 +2,13 @@ package repository
 
 import (
 	"errors"
+	"time"
 
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
 	"github.com/DAF-Bridge/asaiasa-Backend/utils"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type userRepository struct {
@@ -32,14 +34,6 @@ func (r userRepository) FindByEmail(email string) (*models.User, error) {
 	return &user, nil
 }
 
-func (r userRepository) FindByProviderID(providerID string) (*models.User, error) {
-	var user models.User
-	if err := r.db.Where("provider_id = ?", providerID).First(&user).Error; err != nil {
-		return nil, err
-	}
-	return &user, nil
-}
-
 func (r userRepository) Create(user *models.User) error {
 	tx := r.db.Begin()
 
@@ -66,14 +60,17 @@ func (r userRepository) GetAll() ([]models.User, error) {
 
 func (r userRepository) GetProfileByUserID(userId uuid.UUID) (*models.Profile, error) {
 	var userProfile models.Profile
-	if err := r.db.Preload("User").Where("User_ID = ?", userId).First(&userProfile).Error; err != nil {
+	if err := r.db.Preload("User").Preload("Experiences", func(db *gorm.DB) *gorm.DB {
+		return db.Order(ExperienceOrder)
+	}).Where("User_ID = ?", userId).First(&userProfile).Error; err != nil {
 		return nil, err
 	}
 	return &userProfile, nil
 }
 
 func (r userRepository) UpdateProfile(profile *models.Profile) error {
-	if err := r.db.Save(profile).Error; err != nil {
+	// Experiences and the user are loaded for the response and are not saved from here
+	if err := r.db.Omit(clause.Associations).Save(profile).Error; err != nil {
 		return err
 	}
 	return nil
@@ -112,6 +109,39 @@ func (r userRepository) FindByID(userID uuid.UUID) (*models.User, error) {
 	return &user, nil
 }
 
+func (r userRepository) Search(query string, page int, size int) ([]models.User, int64, error) {
+	db := r.db.Model(&models.User{})
+	if query != "" {
+		pattern := "%" + query + "%"
+		db = db.Where("name ILIKE ? OR email ILIKE ?", pattern, pattern)
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var users []models.User
+	err := db.Order("created_at DESC").
+		Offset((page - 1) * size).
+		Limit(size).
+		Find(&users).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return users, total, nil
+}
+
+func (r userRepository) UpdateSuspension(userID uuid.UUID, suspendedAt *time.Time, reason string) error {
+	result := r.db.Model(&models.User{}).Where("id = ?", userID).Updates(map[string]interface{}{
+		"suspended_at":     suspendedAt,
+		"suspended_reason": reason,
+	})
+
+	return utils.GormErrorAndRowsAffected(result)
+}
+
 // ----------------------------------
 // 		UserPreferenceRepository
 // ----------------------------------
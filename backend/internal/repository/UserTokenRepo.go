@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type userTokenRepository struct {
+	db *gorm.DB
+}
+
+func NewUserTokenRepository(db *gorm.DB) models.UserTokenRepository {
+	return userTokenRepository{db: db}
+}
+
+func (r userTokenRepository) Create(token *models.UserToken) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ? AND purpose = ? AND used_at IS NULL", token.UserID, token.Purpose).
+			Delete(&models.UserToken{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(token).Error
+	})
+}
+
+func (r userTokenRepository) VerifyEmail(tokenHash string) (*models.UserToken, error) {
+	var token *models.UserToken
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		token, err = consumeUserToken(tx, models.UserTokenVerifyEmail, tokenHash)
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&models.User{}).
+			Where("id = ? AND email_verified_at IS NULL", token.UserID).
+			Update("email_verified_at", time.Now()).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func (r userTokenRepository) ResetPassword(tokenHash string, hashedPassword string) (*models.UserToken, error) {
+	var token *models.UserToken
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		token, err = consumeUserToken(tx, models.UserTokenResetPassword, tokenHash)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.User{}).
+			Where("id = ?", token.UserID).
+			Update("password", hashedPassword).Error; err != nil {
+			return err
+		}
+
+		// Receiving the reset mail proves ownership of the inbox
+		return tx.Model(&models.User{}).
+			Where("id = ? AND email_verified_at IS NULL", token.UserID).
+			Update("email_verified_at", time.Now()).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// consumeUserToken locks a usable token and marks it as used, returning
+// gorm.ErrRecordNotFound when the token is unknown, expired or already used.
+func consumeUserToken(tx *gorm.DB, purpose models.UserTokenPurpose, tokenHash string) (*models.UserToken, error) {
+	var token models.UserToken
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("token_hash = ? AND purpose = ? AND used_at IS NULL AND expires_at > ?", tokenHash, purpose, time.Now()).
+		First(&token).Error; err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := tx.Model(&token).Update("used_at", now).Error; err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
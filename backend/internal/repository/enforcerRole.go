@@ -13,6 +13,7 @@ type EnforcerRoleRepository interface {
 	GetAllDomains() ([]string, error)
 
 	GetDomainsByUser(user string) []string
-	ClearAllGrouping() (bool, error)
+	// ClearOrganizationGrouping removes every role grant except those in authorization.GlobalDomain
+	ClearOrganizationGrouping() (bool, error)
 	AddGroupingPolicies(groupingPolicies [][]string) (bool, error)
 }
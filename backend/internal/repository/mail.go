@@ -16,3 +16,48 @@ type InviteMailBody struct {
 type MailRepository interface {
 	SendInvitedMail(InviteMailConfig) error
 }
+
+type AuthMailConfig struct {
+	ToEmail string
+	Subject string
+	Body    AuthMailBody
+}
+
+type AuthMailBody struct {
+	Name  string
+	Token string
+}
+
+type AuthMailRepository interface {
+	SendVerificationMail(AuthMailConfig) error
+	SendPasswordResetMail(AuthMailConfig) error
+}
+
+type DigestMailConfig struct {
+	ToEmail string
+	Subject string
+	Body    DigestMailBody
+}
+
+type DigestMailBody struct {
+	Name     string
+	Sections []DigestMailSection
+}
+
+// DigestMailSection lists the new results of one saved search
+type DigestMailSection struct {
+	Name             string
+	UnsubscribeToken string
+	Items            []DigestMailItem
+}
+
+// DigestMailItem is one job or event. Path is relative to the web app, e.g. /jobs/12.
+type DigestMailItem struct {
+	Title  string
+	Detail string
+	Path   string
+}
+
+type DigestMailRepository interface {
+	SendDigestMail(DigestMailConfig) error
+}
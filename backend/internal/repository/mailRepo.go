@@ -55,3 +55,114 @@ func (i *InviteMailRepository) makeHtmlInviteBody(Body InviteMailBody) (string,
 	return tpl.String(), nil
 
 }
+
+type authMailRepository struct {
+	mailserver           *gomail.Dialer
+	verifyEmailTmpl      *template.Template
+	resetPasswordTmpl    *template.Template
+	baseVerifyEmailURL   string
+	baseResetPasswordURL string
+}
+
+func NewAuthMailRepository(mailserver *gomail.Dialer, verifyEmailTmpl *template.Template, resetPasswordTmpl *template.Template,
+	baseVerifyEmailURL string, baseResetPasswordURL string) AuthMailRepository {
+	return &authMailRepository{
+		mailserver:           mailserver,
+		verifyEmailTmpl:      verifyEmailTmpl,
+		resetPasswordTmpl:    resetPasswordTmpl,
+		baseVerifyEmailURL:   baseVerifyEmailURL,
+		baseResetPasswordURL: baseResetPasswordURL,
+	}
+}
+
+func (a *authMailRepository) SendVerificationMail(config AuthMailConfig) error {
+	return a.send(config, a.verifyEmailTmpl, a.baseVerifyEmailURL)
+}
+
+func (a *authMailRepository) SendPasswordResetMail(config AuthMailConfig) error {
+	return a.send(config, a.resetPasswordTmpl, a.baseResetPasswordURL)
+}
+
+func (a *authMailRepository) send(config AuthMailConfig, tmpl *template.Template, baseURL string) error {
+	dataInTmpl := struct {
+		User string
+		URL  string
+	}{
+		User: config.Body.Name,
+		URL:  baseURL + config.Body.Token,
+	}
+
+	var tpl bytes.Buffer
+	if err := tmpl.Execute(&tpl, dataInTmpl); err != nil {
+		return err
+	}
+
+	m := gomail.NewMessage()
+	m.SetHeader("From", a.mailserver.Username)
+	m.SetHeader("To", config.ToEmail)
+	m.SetHeader("Subject", config.Subject)
+	m.SetBody("text/html", tpl.String())
+	return a.mailserver.DialAndSend(m)
+}
+
+type digestMailRepository struct {
+	mailserver         *gomail.Dialer
+	tmpl               *template.Template
+	baseURL            string
+	baseUnsubscribeURL string
+}
+
+func NewDigestMailRepository(mailserver *gomail.Dialer, tmpl *template.Template, baseURL string, baseUnsubscribeURL string) DigestMailRepository {
+	return &digestMailRepository{
+		mailserver:         mailserver,
+		tmpl:               tmpl,
+		baseURL:            baseURL,
+		baseUnsubscribeURL: baseUnsubscribeURL,
+	}
+}
+
+func (d *digestMailRepository) SendDigestMail(config DigestMailConfig) error {
+	type item struct {
+		Title  string
+		Detail string
+		URL    string
+	}
+	type section struct {
+		Name           string
+		UnsubscribeURL string
+		Items          []item
+	}
+
+	sections := make([]section, 0, len(config.Body.Sections))
+	for _, s := range config.Body.Sections {
+		items := make([]item, 0, len(s.Items))
+		for _, i := range s.Items {
+			items = append(items, item{Title: i.Title, Detail: i.Detail, URL: d.baseURL + i.Path})
+		}
+		sections = append(sections, section{
+			Name:           s.Name,
+			UnsubscribeURL: d.baseUnsubscribeURL + s.UnsubscribeToken,
+			Items:          items,
+		})
+	}
+
+	dataInTmpl := struct {
+		User     string
+		Sections []section
+	}{
+		User:     config.Body.Name,
+		Sections: sections,
+	}
+
+	var tpl bytes.Buffer
+	if err := d.tmpl.Execute(&tpl, dataInTmpl); err != nil {
+		return err
+	}
+
+	m := gomail.NewMessage()
+	m.SetHeader("From", d.mailserver.Username)
+	m.SetHeader("To", config.ToEmail)
+	m.SetHeader("Subject", config.Subject)
+	m.SetBody("text/html", tpl.String())
+	return d.mailserver.DialAndSend(m)
+}
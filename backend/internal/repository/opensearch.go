@@ -8,4 +8,7 @@ type OpenSearchRepository interface {
 
 	CreateOrUpdateJob(job *dto.JobDocument) error
 	DeleteJob(job dto.JobDocument) error
+
+	// DeleteOrganization removes the organization and every event and job it published
+	DeleteOrganization(orgID uint) error
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/DAF-Bridge/asaiasa-Backend/errs"
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
@@ -84,3 +85,27 @@ func (os *openSearchRepository) DeleteJob(job dto.JobDocument) error {
 
 	return nil
 }
+
+func (os *openSearchRepository) DeleteOrganization(orgID uint) error {
+	res, err := os.es.Delete("organizations", fmt.Sprintf("%d", orgID))
+	if err != nil {
+		return errs.NewCannotBeProcessedError(fmt.Sprintf("error deleting document: %v", err))
+	}
+	res.Body.Close()
+
+	query := fmt.Sprintf(`{"query":{"term":{"organization.id":%d}}}`, orgID)
+	res, err = os.es.DeleteByQuery([]string{"events", "jobs"}, strings.NewReader(query),
+		os.es.DeleteByQuery.WithConflicts("proceed"),
+		os.es.DeleteByQuery.WithRefresh(true))
+	if err != nil {
+		return errs.NewCannotBeProcessedError(fmt.Sprintf("error deleting documents of organization: %v", err))
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return errs.NewCannotBeProcessedError(fmt.Sprintf("error deleting documents of organization: %s", res.String()))
+	}
+
+	logs.Info(fmt.Sprintf("Organization %d and its events and jobs removed from the index", orgID))
+
+	return nil
+}
@@ -11,6 +11,7 @@ type OrganizationRepository interface {
 	GetAllIndustries() ([]models.Industry, error)
 	GetByOrgID(id uint) (*models.Organization, error)
 	//GetByOrgID(userID uuid.UUID, id uint) (*models.Organization, error)
+	// GetAllOrganizations and GetOrgsPaginate only return approved organizations
 	GetAllOrganizations() ([]models.Organization, error)
 	//GetAllOrganizations(userID uuid.UUID) ([]models.Organization, error)
 	GetOrgsPaginate(page uint, size uint) ([]models.Organization, error)
@@ -21,6 +22,7 @@ type OrganizationRepository interface {
 	UpdateOrganizationBackgroundPicture(id uint, picURL string) error
 	DeleteOrganization(org uint) error
 	//DeleteOrganization(userID uuid.UUID, org uint) error
+	CountFollowers(orgIDs []uint) (map[uint]int64, error)
 }
 
 type OrganizationContactRepository interface {
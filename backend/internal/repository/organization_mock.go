@@ -206,6 +206,10 @@ func (r organizationRepositoryMock) DeleteOrganization(org uint) error {
 	return nil
 }
 
+func (r organizationRepositoryMock) CountFollowers(orgIDs []uint) (map[uint]int64, error) {
+	return map[uint]int64{}, nil
+}
+
 // ----------------------------------------------
 // 			OrganizationContactRepository
 // ----------------------------------------------
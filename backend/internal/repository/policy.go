@@ -1,9 +1,8 @@
 package repository
 
-type Policy struct {
-	Resource string `json:"resource"`
-	Action   string `json:"action"`
-}
+import "github.com/DAF-Bridge/asaiasa-Backend/pkg/authorization"
+
+type Policy = authorization.Policy
 
 func CreatePolices(role string, policies []Policy) [][]string {
 	var polices [][]string
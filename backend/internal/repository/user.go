@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -16,6 +18,9 @@ type UserRepository interface {
 	BeginTransaction() *gorm.DB
 	FindByID(userID uuid.UUID) (*models.User, error)
 	FindInUserIdList(userIds []uuid.UUID) ([]models.User, error)
+	// Search matches query against names and emails, newest users first
+	Search(query string, page int, size int) ([]models.User, int64, error)
+	UpdateSuspension(userID uuid.UUID, suspendedAt *time.Time, reason string) error
 }
 
 type UserPreferenceRepository interface {
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"gorm.io/gorm"
+)
+
+// VisibleContent returns the scope of every query serving events or jobs publicly: it keeps the
+// rows of table, events or org_open_jobs, that are not hidden by moderation and whose
+// organization is approved. The columns are qualified so that it also applies to joins.
+func VisibleContent(table string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(table+".hidden_at IS NULL").
+			Where(table+".organization_id IN (SELECT id FROM organizations WHERE status = ?)", models.OrgStatusApproved)
+	}
+}
+
+// VisibleOrganizations is the scope of every query serving organizations publicly
+func VisibleOrganizations(db *gorm.DB) *gorm.DB {
+	return db.Where("organizations.status = ?", models.OrgStatusApproved)
+}
+
+// reindexOrganizationContent touches the visible events and jobs of an organization that becomes
+// approved again, so that the CDC consumer indexes them
+func reindexOrganizationContent(tx *gorm.DB, orgID uint) error {
+	for _, model := range []interface{}{&models.Event{}, &models.OrgOpenJob{}} {
+		err := tx.Model(model).
+			Where("organization_id = ? AND hidden_at IS NULL", orgID).
+			Update("updated_at", time.Now()).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
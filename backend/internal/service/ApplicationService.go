@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/infrastructure"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
+	"github.com/DAF-Bridge/asaiasa-Backend/logs"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+const (
+	maxResumeSize      = 5 << 20 // 5 MB
+	maxCoverNoteLength = 5000
+	resumeURLTTL       = 15 * time.Minute
+)
+
+var allowedResumeExtensions = map[string]bool{".pdf": true, ".doc": true, ".docx": true}
+
+type applicationService struct {
+	applicationRepo models.ApplicationRepository
+	jobRepo         repository.OrgOpenJobRepository
+	s3              *infrastructure.S3Uploader
+}
+
+func NewApplicationService(applicationRepo models.ApplicationRepository, jobRepo repository.OrgOpenJobRepository, s3 *infrastructure.S3Uploader) models.ApplicationService {
+	return applicationService{
+		applicationRepo: applicationRepo,
+		jobRepo:         jobRepo,
+		s3:              s3,
+	}
+}
+
+func (s applicationService) Apply(ctx context.Context, userID uuid.UUID, jobID uint, coverNote string, resume multipart.File, resumeHeader *multipart.FileHeader) (*models.Application, error) {
+	job, err := s.jobRepo.GetJobByID(jobID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("job not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if job.Status != string(models.JobStatusPublished) || job.HiddenAt != nil {
+		return nil, errs.NewBadRequestError("this job is not accepting applications")
+	}
+
+	if len(coverNote) > maxCoverNoteLength {
+		return nil, errs.NewBadRequestError(fmt.Sprintf("cover note must be at most %d characters", maxCoverNoteLength))
+	}
+
+	if resume == nil || resumeHeader == nil {
+		return nil, errs.NewBadRequestError("resume is required")
+	}
+	if resumeHeader.Size > maxResumeSize {
+		return nil, errs.NewBadRequestError("resume must be at most 5 MB")
+	}
+	if !allowedResumeExtensions[strings.ToLower(filepath.Ext(resumeHeader.Filename))] {
+		return nil, errs.NewBadRequestError("resume must be a PDF or Word document")
+	}
+
+	resumeKey, err := s.s3.UploadApplicationResume(ctx, resume, resumeHeader, jobID, userID)
+	if err != nil {
+		logs.Error(fmt.Sprintf("Failed to upload resume: %v", err))
+		return nil, errs.NewUnexpectedError()
+	}
+
+	application := &models.Application{
+		JobID:     jobID,
+		UserID:    userID,
+		CoverNote: coverNote,
+		ResumeKey: resumeKey,
+		Status:    models.ApplicationStatusSubmitted,
+	}
+
+	if err := s.applicationRepo.Create(application); err != nil {
+		// The resume is only reachable through its application
+		if deleteErr := s.s3.DeleteObject(ctx, resumeKey); deleteErr != nil {
+			logs.Warn(fmt.Sprintf("Failed to delete the resume of an unsaved application: %v", deleteErr))
+		}
+
+		var pqErr *pgconn.PgError
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return nil, errs.NewConflictError("you have already applied to this job")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return s.getApplication(application.ID)
+}
+
+func (s applicationService) ListMyApplications(userID uuid.UUID) ([]models.Application, error) {
+	applications, err := s.applicationRepo.ListByUserID(userID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return applications, nil
+}
+
+func (s applicationService) GetMyApplication(userID uuid.UUID, id uint) (*models.Application, error) {
+	application, err := s.getApplication(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if application.UserID != userID {
+		return nil, errs.NewNotFoundError("application not found")
+	}
+
+	return application, nil
+}
+
+func (s applicationService) Withdraw(userID uuid.UUID, id uint) (*models.Application, error) {
+	application, err := s.GetMyApplication(userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !application.Status.CanTransitionTo(models.ApplicationStatusWithdrawn) {
+		return nil, errs.NewBadRequestError(fmt.Sprintf("an application that is %s can no longer be withdrawn", application.Status))
+	}
+
+	return s.moveApplication(application, models.ApplicationStatusWithdrawn, userID, "")
+}
+
+func (s applicationService) ListJobApplications(orgID uint, jobID uint, status models.ApplicationStatus) ([]models.Application, error) {
+	if status != "" && !isApplicationStatus(status) {
+		return nil, errs.NewBadRequestError(fmt.Sprintf("unknown application status: %s", status))
+	}
+
+	if err := s.checkJobInOrg(orgID, jobID); err != nil {
+		return nil, err
+	}
+
+	applications, err := s.applicationRepo.ListByJobID(jobID, status)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return applications, nil
+}
+
+func (s applicationService) GetJobApplication(orgID uint, jobID uint, id uint) (*models.Application, error) {
+	if err := s.checkJobInOrg(orgID, jobID); err != nil {
+		return nil, err
+	}
+
+	application, err := s.applicationRepo.GetByIDAndJobID(jobID, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("application not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return application, nil
+}
+
+func (s applicationService) UpdateStatus(orgID uint, jobID uint, id uint, changedBy uuid.UUID, status models.ApplicationStatus, note string) (*models.Application, error) {
+	if !isApplicationStatus(status) {
+		return nil, errs.NewBadRequestError(fmt.Sprintf("unknown application status: %s", status))
+	}
+	if status == models.ApplicationStatusWithdrawn {
+		return nil, errs.NewBadRequestError("only the applicant can withdraw an application")
+	}
+
+	application, err := s.GetJobApplication(orgID, jobID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !application.Status.CanTransitionTo(status) {
+		return nil, errs.NewBadRequestError(fmt.Sprintf("cannot move an application from %s to %s", application.Status, status))
+	}
+
+	return s.moveApplication(application, status, changedBy, note)
+}
+
+func (s applicationService) ResumeURL(ctx context.Context, application *models.Application) (string, error) {
+	url, err := s.s3.PresignGetURL(ctx, application.ResumeKey, resumeURLTTL)
+	if err != nil {
+		logs.Error(err)
+		return "", errs.NewUnexpectedError()
+	}
+
+	return url, nil
+}
+
+func (s applicationService) moveApplication(application *models.Application, status models.ApplicationStatus, changedBy uuid.UUID, note string) (*models.Application, error) {
+	err := s.applicationRepo.UpdateStatus(&models.ApplicationStatusChange{
+		ApplicationID: application.ID,
+		FromStatus:    application.Status,
+		ToStatus:      status,
+		ChangedByID:   changedBy,
+		Note:          note,
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrApplicationStatusChanged) {
+			return nil, errs.NewConflictError("the application was updated in the meantime, reload it and try again")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return s.getApplication(application.ID)
+}
+
+func (s applicationService) getApplication(id uint) (*models.Application, error) {
+	application, err := s.applicationRepo.GetByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("application not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return application, nil
+}
+
+func (s applicationService) checkJobInOrg(orgID uint, jobID uint) error {
+	if _, err := s.jobRepo.GetJobByIDWithOrgID(orgID, jobID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("job not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func isApplicationStatus(status models.ApplicationStatus) bool {
+	switch status {
+	case models.ApplicationStatusSubmitted, models.ApplicationStatusReviewing, models.ApplicationStatusInterview,
+		models.ApplicationStatusOffered, models.ApplicationStatusRejected, models.ApplicationStatusWithdrawn:
+		return true
+	}
+	return false
+}
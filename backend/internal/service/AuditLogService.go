@@ -0,0 +1,51 @@
+package service
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/logs"
+)
+
+const numberOfAuditLogs = 20
+
+type auditLogService struct {
+	auditLogRepo models.AuditLogRepository
+}
+
+func NewAuditLogService(auditLogRepo models.AuditLogRepository) AuditLogService {
+	return auditLogService{auditLogRepo: auditLogRepo}
+}
+
+func (s auditLogService) ListAuditLogs(orgID uint, filter models.AuditLogFilter, page int) (*dto.PaginatedAuditLogResponse, error) {
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return nil, errs.NewBadRequestError("from must be before to")
+	}
+
+	auditLogs, total, err := s.auditLogRepo.ListByOrganization(orgID, filter, page, numberOfAuditLogs)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	responses := make([]dto.AuditLogResponse, 0, len(auditLogs))
+	for _, auditLog := range auditLogs {
+		responses = append(responses, dto.AuditLogResponse{
+			ID:             auditLog.ID,
+			ActorID:        auditLog.ActorID.String(),
+			OrganizationID: auditLog.OrganizationID,
+			Resource:       auditLog.Resource,
+			Action:         auditLog.Action,
+			Method:         auditLog.Method,
+			Path:           auditLog.Path,
+			Changes:        json.RawMessage(auditLog.Changes),
+			IP:             auditLog.IP,
+			CreatedAt:      auditLog.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return &dto.PaginatedAuditLogResponse{Logs: responses, TotalLogs: total}, nil
+}
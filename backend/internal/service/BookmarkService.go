@@ -0,0 +1,183 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/logs"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+type bookmarkService struct {
+	bookmarkRepo models.BookmarkRepository
+}
+
+func NewBookmarkService(bookmarkRepo models.BookmarkRepository) BookmarkService {
+	return bookmarkService{bookmarkRepo: bookmarkRepo}
+}
+
+func (s bookmarkService) AddBookmark(userID uuid.UUID, req dto.BookmarkRequest) (*dto.BookmarkResponse, error) {
+	targetType := models.BookmarkTargetType(req.TargetType)
+	exists, err := s.bookmarkRepo.TargetExists(targetType, req.TargetID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+	if !exists {
+		return nil, errs.NewNotFoundError(req.TargetType + " not found")
+	}
+
+	bookmark := &models.Bookmark{
+		UserID:     userID,
+		TargetType: targetType,
+		TargetID:   req.TargetID,
+	}
+	if err := s.bookmarkRepo.Create(bookmark); err != nil {
+		var pqErr *pgconn.PgError
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return nil, errs.NewConflictError("this " + req.TargetType + " is already bookmarked")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	responses, err := s.buildBookmarkResponses([]models.Bookmark{*bookmark})
+	if err != nil {
+		return nil, err
+	}
+	if len(responses) == 0 {
+		// The target was deleted or moderated right after the existence check
+		return nil, errs.NewNotFoundError(req.TargetType + " not found")
+	}
+
+	return &responses[0], nil
+}
+
+func (s bookmarkService) RemoveBookmark(userID uuid.UUID, targetType models.BookmarkTargetType, targetID uint) error {
+	if err := s.bookmarkRepo.Delete(userID, targetType, targetID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("bookmark not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s bookmarkService) ListBookmarks(userID uuid.UUID, targetType models.BookmarkTargetType) ([]dto.BookmarkResponse, error) {
+	bookmarks, err := s.bookmarkRepo.ListByUserID(userID, targetType)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return s.buildBookmarkResponses(bookmarks)
+}
+
+func (s bookmarkService) BookmarkedIDs(userID uuid.UUID, targetType models.BookmarkTargetType, targetIDs []uint) (map[uint]bool, error) {
+	bookmarked, err := s.bookmarkRepo.FilterBookmarked(userID, targetType, targetIDs)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	result := make(map[uint]bool, len(bookmarked))
+	for _, id := range bookmarked {
+		result[id] = true
+	}
+
+	return result, nil
+}
+
+// buildBookmarkResponses loads the bookmarked items and leaves out bookmarks whose item was deleted
+// or is no longer publicly visible
+func (s bookmarkService) buildBookmarkResponses(bookmarks []models.Bookmark) ([]dto.BookmarkResponse, error) {
+	idsByType := map[models.BookmarkTargetType][]uint{}
+	for _, bookmark := range bookmarks {
+		idsByType[bookmark.TargetType] = append(idsByType[bookmark.TargetType], bookmark.TargetID)
+	}
+
+	events := map[uint]dto.EventDocumentDTOResponse{}
+	jobs := map[uint]dto.JobDocumentDTOResponse{}
+	organizations := map[uint]dto.OrganizationShortDocument{}
+	bookmarked := true
+
+	if ids := idsByType[models.BookmarkTargetEvent]; len(ids) > 0 {
+		found, err := s.bookmarkRepo.GetEventsByIDs(ids)
+		if err != nil {
+			logs.Error(err)
+			return nil, errs.NewUnexpectedError()
+		}
+		for _, event := range found {
+			response := ConvertToEventDocumentResponse(event)
+			response.IsBookmarked = &bookmarked
+			events[event.ID] = response
+		}
+	}
+
+	if ids := idsByType[models.BookmarkTargetJob]; len(ids) > 0 {
+		found, err := s.bookmarkRepo.GetJobsByIDs(ids)
+		if err != nil {
+			logs.Error(err)
+			return nil, errs.NewUnexpectedError()
+		}
+		for _, job := range found {
+			response := ConvertToJobDocumentDTOResponse(job)
+			response.IsBookmarked = &bookmarked
+			jobs[job.ID] = response
+		}
+	}
+
+	if ids := idsByType[models.BookmarkTargetOrganization]; len(ids) > 0 {
+		found, err := s.bookmarkRepo.GetOrganizationsByIDs(ids)
+		if err != nil {
+			logs.Error(err)
+			return nil, errs.NewUnexpectedError()
+		}
+		for _, org := range found {
+			organizations[org.ID] = dto.OrganizationShortDocument{ID: org.ID, Name: org.Name, PicUrl: org.PicUrl}
+		}
+	}
+
+	responses := make([]dto.BookmarkResponse, 0, len(bookmarks))
+	for _, bookmark := range bookmarks {
+		response := dto.BookmarkResponse{
+			ID:         bookmark.ID,
+			TargetType: string(bookmark.TargetType),
+			TargetID:   bookmark.TargetID,
+			CreatedAt:  bookmark.CreatedAt.Format("2006-01-02T15:04:05"),
+		}
+
+		switch bookmark.TargetType {
+		case models.BookmarkTargetEvent:
+			event, ok := events[bookmark.TargetID]
+			if !ok {
+				continue
+			}
+			response.Event = &event
+		case models.BookmarkTargetJob:
+			job, ok := jobs[bookmark.TargetID]
+			if !ok {
+				continue
+			}
+			response.Job = &job
+		case models.BookmarkTargetOrganization:
+			org, ok := organizations[bookmark.TargetID]
+			if !ok {
+				continue
+			}
+			response.Organization = &org
+		}
+
+		responses = append(responses, response)
+	}
+
+	return responses, nil
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
+	"github.com/DAF-Bridge/asaiasa-Backend/logs"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+type eventParticipantService struct {
+	participantRepo models.EventParticipantRepository
+	eventRepo       repository.EventRepository
+}
+
+func NewEventParticipantService(participantRepo models.EventParticipantRepository, eventRepo repository.EventRepository) models.EventParticipantService {
+	return eventParticipantService{
+		participantRepo: participantRepo,
+		eventRepo:       eventRepo,
+	}
+}
+
+func (s eventParticipantService) Register(userID uuid.UUID, eventID uint, isVisible bool) (*models.EventParticipant, error) {
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+	if !event.VisibleToPublic() {
+		return nil, errs.NewNotFoundError("event not found")
+	}
+
+	// Paid events are joined by purchasing a ticket instead
+	if event.PriceType == string(models.Paid) {
+		return nil, errs.NewBadRequestError("this event requires a ticket purchase")
+	}
+
+	participant := &models.EventParticipant{
+		UserId:    userID,
+		EventId:   eventID,
+		IsVisible: isVisible,
+	}
+
+	if err := s.participantRepo.Register(participant); err != nil {
+		if errors.Is(err, models.ErrEventFull) {
+			return nil, errs.NewConflictError("event has reached its capacity")
+		}
+
+		var pqErr *pgconn.PgError
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return nil, errs.NewConflictError("already registered for this event")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return participant, nil
+}
+
+func (s eventParticipantService) Unregister(userID uuid.UUID, eventID uint) error {
+	if err := s.participantRepo.Unregister(userID, eventID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("registration not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s eventParticipantService) UpdateVisibility(userID uuid.UUID, eventID uint, isVisible bool) error {
+	if err := s.participantRepo.UpdateVisibility(userID, eventID, isVisible); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("registration not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s eventParticipantService) GetMyRegistration(userID uuid.UUID, eventID uint) (*models.EventParticipant, error) {
+	participant, err := s.participantRepo.GetByUserIDAndEventID(userID, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("registration not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return participant, nil
+}
+
+func (s eventParticipantService) ListPublicParticipants(eventID uint) ([]models.EventParticipant, int64, error) {
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, 0, errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return nil, 0, errs.NewUnexpectedError()
+	}
+	if !event.VisibleToPublic() {
+		return nil, 0, errs.NewNotFoundError("event not found")
+	}
+
+	participants, err := s.participantRepo.GetVisibleByEventID(eventID)
+	if err != nil {
+		logs.Error(err)
+		return nil, 0, errs.NewUnexpectedError()
+	}
+
+	// The total includes hidden participants so the public count stays accurate
+	total, err := s.participantRepo.CountByEventID(eventID)
+	if err != nil {
+		logs.Error(err)
+		return nil, 0, errs.NewUnexpectedError()
+	}
+
+	return participants, total, nil
+}
+
+func (s eventParticipantService) ListParticipants(orgID uint, eventID uint) ([]models.EventParticipant, error) {
+	if _, err := s.eventRepo.GetByIDwithOrgID(orgID, eventID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	participants, err := s.participantRepo.GetAllByEventID(eventID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return participants, nil
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"mime/multipart"
 
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
@@ -27,17 +28,20 @@ type eventService struct {
 	DB             *gorm.DB
 	OS             *opensearch.Client
 	S3             *infrastructure.S3Uploader
+	notifications  NotificationPublisher
 }
 
 //--------------------------------------------//
 
-func NewEventService(eventRepo repository.EventRepository, openSearchRepo repository.OpenSearchRepository, db *gorm.DB, os *opensearch.Client, s3 *infrastructure.S3Uploader) EventService {
+func NewEventService(eventRepo repository.EventRepository, openSearchRepo repository.OpenSearchRepository, db *gorm.DB, os *opensearch.Client, s3 *infrastructure.S3Uploader,
+	notifications NotificationPublisher) EventService {
 	return eventService{
 		eventRepo:      eventRepo,
 		openSearchRepo: openSearchRepo,
 		DB:             db,
 		OS:             os,
-		S3:             s3}
+		S3:             s3,
+		notifications:  notifications}
 }
 
 func (s eventService) CountEventByOrgID(orgID uint) (int64, error) {
@@ -92,6 +96,7 @@ func (s eventService) NewEvent(orgID uint, req dto.NewEventRequest, ctx context.
 	}
 
 	event := requestConvertToEvent(orgID, req, categories, contacts)
+	event.PublishedAt = models.PublishedAtFor(nil, event.Status)
 
 	err = s.eventRepo.Create(orgID, &event)
 	if err != nil {
@@ -130,6 +135,10 @@ func (s eventService) NewEvent(orgID uint, req dto.NewEventRequest, ctx context.
 		logs.Error("Failed to update event in OpenSearch, but database operation was successful")
 	}
 
+	if event.PublishedAt != nil {
+		s.notifyFollowers(event)
+	}
+
 	return nil
 }
 
@@ -155,6 +164,15 @@ func (s eventService) GetAllEvents() ([]dto.EventResponses, error) {
 }
 
 func (s eventService) GetAllEventsByOrgID(orgID uint) ([]dto.EventResponses, error) {
+	return s.listEventsByOrgID(orgID, true)
+}
+
+func (s eventService) GetAllManagedEventsByOrgID(orgID uint) ([]dto.EventResponses, error) {
+	return s.listEventsByOrgID(orgID, false)
+}
+
+// listEventsByOrgID lists the events of an organization, only those VisibleToPublic when public is set
+func (s eventService) listEventsByOrgID(orgID uint, public bool) ([]dto.EventResponses, error) {
 	events, err := s.eventRepo.GetAllByOrgID(orgID)
 
 	if err != nil {
@@ -167,6 +185,9 @@ func (s eventService) GetAllEventsByOrgID(orgID uint) ([]dto.EventResponses, err
 	}
 	EventResponses := make([]dto.EventResponses, 0)
 	for _, event := range events {
+		if public && !event.VisibleToPublic() {
+			continue
+		}
 		eventResponse := ConvertToEventResponse(event)
 		EventResponses = append(EventResponses, eventResponse)
 	}
@@ -184,12 +205,24 @@ func (s eventService) GetEventByID(eventID uint) (*dto.EventResponses, error) {
 		logs.Error(err)
 		return nil, errs.NewUnexpectedError()
 	}
+	if !event.VisibleToPublic() {
+		return nil, errs.NewNotFoundError("event not found")
+	}
 
 	eventResponse := ConvertToEventResponse(*event)
 	return &eventResponse, nil
 }
 
 func (s eventService) GetEventByIDwithOrgID(orgID uint, eventID uint) (*dto.EventResponses, error) {
+	return s.getEventByIDwithOrgID(orgID, eventID, true)
+}
+
+func (s eventService) GetManagedEventByID(orgID uint, eventID uint) (*dto.EventResponses, error) {
+	return s.getEventByIDwithOrgID(orgID, eventID, false)
+}
+
+// getEventByIDwithOrgID gets an event of an organization, not found unless VisibleToPublic when public is set
+func (s eventService) getEventByIDwithOrgID(orgID uint, eventID uint, public bool) (*dto.EventResponses, error) {
 	event, err := s.eventRepo.GetByIDwithOrgID(orgID, eventID)
 	if err != nil {
 
@@ -200,6 +233,9 @@ func (s eventService) GetEventByIDwithOrgID(orgID uint, eventID uint) (*dto.Even
 		logs.Error(err)
 		return nil, errs.NewUnexpectedError()
 	}
+	if public && !event.VisibleToPublic() {
+		return nil, errs.NewNotFoundError("event not found")
+	}
 
 	eventResponse := ConvertToEventResponse(*event)
 
@@ -314,6 +350,7 @@ func (s eventService) UpdateEvent(orgID uint, eventID uint, req dto.NewEventRequ
 	// Convert request to Event
 	event := requestConvertToEvent(orgID, req, categories, contacts)
 	event.ID = eventID
+	event.PublishedAt = models.PublishedAtFor(existingEvent.PublishedAt, event.Status)
 
 	if file != nil {
 		picURL, err := s.S3.UploadEventPictureFile(ctx, file, fileHeader, orgID, eventID)
@@ -337,6 +374,12 @@ func (s eventService) UpdateEvent(orgID uint, eventID uint, req dto.NewEventRequ
 		return nil, errs.NewUnexpectedError()
 	}
 
+	// A hidden event stays out of search until it is restored
+	if updateEvent.HiddenAt != nil {
+		eventResponse := ConvertToEventResponse(*updateEvent)
+		return &eventResponse, nil
+	}
+
 	// Convert to EventDocument and index in OpenSearch
 	eventDoc := convertToEventDocument(*updateEvent)
 	err = s.openSearchRepo.CreateOrUpdateEvent(eventDoc)
@@ -346,11 +389,48 @@ func (s eventService) UpdateEvent(orgID uint, eventID uint, req dto.NewEventRequ
 		logs.Error("Failed to update event in OpenSearch, but database operation was successful")
 	}
 
+	if existingEvent.PublishedAt == nil && updateEvent.PublishedAt != nil {
+		s.notifyFollowers(*updateEvent)
+	}
+
 	eventResponse := ConvertToEventResponse(*updateEvent)
 
 	return &eventResponse, nil
 }
 
+// notifyFollowers tells the followers of the organization about an event it just published. An
+// organization can have many followers, so the notifications are written in the background and the
+// request publishing the event does not wait for them.
+func (s eventService) notifyFollowers(event models.Event) {
+	if s.notifications == nil {
+		return
+	}
+
+	publisher := "An organization you follow"
+	if event.Organization.Name != "" {
+		publisher = event.Organization.Name
+	}
+
+	orgID, eventID := event.OrganizationID, event.ID
+	message := models.NotificationMessage{
+		Type:           models.NotificationFollowedOrgEvent,
+		Title:          event.Name,
+		Body:           publisher + " published a new event.",
+		OrganizationID: &orgID,
+		TargetID:       &eventID,
+	}
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logs.Error(fmt.Sprintf("Notifying the followers of organization %d about event %d panicked: %v", orgID, eventID, r))
+			}
+		}()
+
+		s.notifications.PublishToOrganizationFollowers(orgID, message)
+	}()
+}
+
 func (s eventService) UploadEventPicture(ctx context.Context, file multipart.File, fileHeader *multipart.FileHeader, orgID uint, eventID uint) (string, error) {
 	picURL, err := s.S3.UploadEventPictureFile(ctx, file, fileHeader, orgID, eventID)
 
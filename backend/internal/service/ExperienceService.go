@@ -0,0 +1,158 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/logs"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type experienceService struct {
+	profileRepo    models.ProfileRepository
+	experienceRepo models.ExperienceRepository
+}
+
+func NewExperienceService(profileRepo models.ProfileRepository, experienceRepo models.ExperienceRepository) models.ExperienceService {
+	return experienceService{
+		profileRepo:    profileRepo,
+		experienceRepo: experienceRepo,
+	}
+}
+
+func (s experienceService) CreateExperience(userID uuid.UUID, experience *models.Experience) error {
+	if err := validateExperience(experience); err != nil {
+		return err
+	}
+
+	profile, err := s.getProfile(userID)
+	if err != nil {
+		return err
+	}
+
+	experience.UUID = uuid.Nil
+	experience.ProfileID = profile.ID
+	if err := s.experienceRepo.Create(experience); err != nil {
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s experienceService) ListExperiencesByUserID(userID uuid.UUID) ([]models.Experience, error) {
+	experiences, err := s.experienceRepo.GetByUserID(userID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return experiences, nil
+}
+
+func (s experienceService) GetExperienceByID(userID uuid.UUID, experienceID uuid.UUID) (*models.Experience, error) {
+	profile, err := s.getProfile(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	experience, err := s.experienceRepo.GetByID(experienceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("experience not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if experience.ProfileID != profile.ID {
+		return nil, errs.NewNotFoundError("experience not found")
+	}
+
+	return experience, nil
+}
+
+func (s experienceService) UpdateExperience(userID uuid.UUID, experience *models.Experience) error {
+	if err := validateExperience(experience); err != nil {
+		return err
+	}
+
+	existing, err := s.GetExperienceByID(userID, experience.UUID)
+	if err != nil {
+		return err
+	}
+
+	existing.Title = experience.Title
+	existing.Description = experience.Description
+	existing.PicUrl = experience.PicUrl
+	existing.StartDate = experience.StartDate
+	existing.EndDate = experience.EndDate
+	existing.Currently = experience.Currently
+
+	if err := s.experienceRepo.Update(existing); err != nil {
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	*experience = *existing
+	return nil
+}
+
+func (s experienceService) DeleteExperience(userID uuid.UUID, experienceID uuid.UUID) error {
+	if _, err := s.GetExperienceByID(userID, experienceID); err != nil {
+		return err
+	}
+
+	if err := s.experienceRepo.Delete(experienceID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("experience not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s experienceService) getProfile(userID uuid.UUID) (*models.Profile, error) {
+	profile, err := s.profileRepo.GetByUserID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("profile not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return profile, nil
+}
+
+// validateExperience checks that the dates describe a single period: an ongoing
+// experience has no end date, a finished one ends on or after the day it started.
+func validateExperience(experience *models.Experience) error {
+	if experience.StartDate.IsZero() {
+		return errs.NewBadRequestError("startDate is required")
+	}
+
+	if experience.Currently {
+		if experience.EndDate != nil {
+			return errs.NewBadRequestError("endDate must be empty for a current experience")
+		}
+		return nil
+	}
+
+	if experience.EndDate == nil {
+		return errs.NewBadRequestError("endDate is required unless currently is true")
+	}
+
+	if experience.EndDate.Before(experience.StartDate) {
+		return errs.NewBadRequestError("endDate must not be before startDate")
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
+	"github.com/DAF-Bridge/asaiasa-Backend/logs"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type followService struct {
+	followRepo models.OrganizationFollowRepository
+	orgRepo    repository.OrganizationRepository
+}
+
+func NewFollowService(followRepo models.OrganizationFollowRepository, orgRepo repository.OrganizationRepository) FollowService {
+	return followService{
+		followRepo: followRepo,
+		orgRepo:    orgRepo,
+	}
+}
+
+func (s followService) Follow(userID uuid.UUID, orgID uint) (*dto.FollowResponse, error) {
+	org, err := s.orgRepo.GetByOrgID(orgID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("organization not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+	// Only approved organizations are public
+	if org.Status != models.OrgStatusApproved {
+		return nil, errs.NewNotFoundError("organization not found")
+	}
+
+	follow := &models.OrganizationFollow{UserID: userID, OrganizationID: orgID}
+	if err := s.followRepo.Follow(follow); err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return s.buildFollowResponse(orgID, true)
+}
+
+func (s followService) Unfollow(userID uuid.UUID, orgID uint) (*dto.FollowResponse, error) {
+	if err := s.followRepo.Unfollow(userID, orgID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("you are not following this organization")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return s.buildFollowResponse(orgID, false)
+}
+
+func (s followService) ListFollowing(userID uuid.UUID) ([]dto.OrganizationShortDocument, error) {
+	orgs, err := s.followRepo.ListFollowedOrganizations(userID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	responses := make([]dto.OrganizationShortDocument, 0, len(orgs))
+	for _, org := range orgs {
+		responses = append(responses, dto.OrganizationShortDocument{ID: org.ID, Name: org.Name, PicUrl: org.PicUrl})
+	}
+
+	return responses, nil
+}
+
+func (s followService) GetFeed(userID uuid.UUID, cursor string, limit int) (*dto.FeedResponse, error) {
+	var after *models.FeedCursor
+	if cursor != "" {
+		decoded, err := decodeFeedCursor(cursor)
+		if err != nil {
+			return nil, errs.NewBadRequestError("invalid cursor")
+		}
+		after = decoded
+	}
+
+	// Read one extra row from each table to know whether another page follows
+	events, err := s.followRepo.ListFeedEvents(userID, after, limit+1)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	jobs, err := s.followRepo.ListFeedJobs(userID, after, limit+1)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	items := make([]dto.FeedItemResponse, 0, limit)
+	var last models.FeedCursor
+	for len(items) < limit && (len(events) > 0 || len(jobs) > 0) {
+		if len(jobs) == 0 || (len(events) > 0 && eventComesFirst(events[0], jobs[0])) {
+			event := ConvertToEventDocumentResponse(events[0])
+			items = append(items, dto.FeedItemResponse{
+				Type:        models.FeedItemEvent,
+				PublishedAt: events[0].PublishedAt.UTC().Format(time.RFC3339),
+				Event:       &event,
+			})
+			last = models.FeedCursor{PublishedAt: *events[0].PublishedAt, Kind: models.FeedItemEvent, ID: events[0].ID}
+			events = events[1:]
+			continue
+		}
+
+		job := ConvertToJobDocumentDTOResponse(jobs[0])
+		items = append(items, dto.FeedItemResponse{
+			Type:        models.FeedItemJob,
+			PublishedAt: jobs[0].PublishedAt.UTC().Format(time.RFC3339),
+			Job:         &job,
+		})
+		last = models.FeedCursor{PublishedAt: *jobs[0].PublishedAt, Kind: models.FeedItemJob, ID: jobs[0].ID}
+		jobs = jobs[1:]
+	}
+
+	response := &dto.FeedResponse{Items: items}
+	if len(events) > 0 || len(jobs) > 0 {
+		response.NextCursor = encodeFeedCursor(last)
+	}
+
+	return response, nil
+}
+
+func (s followService) buildFollowResponse(orgID uint, following bool) (*dto.FollowResponse, error) {
+	counts, err := s.orgRepo.CountFollowers([]uint{orgID})
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return &dto.FollowResponse{
+		OrganizationID: orgID,
+		Following:      following,
+		FollowerCount:  counts[orgID],
+	}, nil
+}
+
+// eventComesFirst reports whether event is shown before job in the feed. Ties on the publish time
+// go to the job, matching the kind order the repository pages by.
+func eventComesFirst(event models.Event, job models.OrgOpenJob) bool {
+	return event.PublishedAt.After(*job.PublishedAt)
+}
+
+func encodeFeedCursor(cursor models.FeedCursor) string {
+	raw := fmt.Sprintf("%d|%s|%d", cursor.PublishedAt.UnixMicro(), cursor.Kind, cursor.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeFeedCursor(cursor string) (*models.FeedCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := strings.Split(string(raw), "|")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed feed cursor")
+	}
+
+	micros, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	if parts[1] != models.FeedItemEvent && parts[1] != models.FeedItemJob {
+		return nil, errors.New("unknown feed item kind")
+	}
+	id, err := strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.FeedCursor{PublishedAt: time.UnixMicro(micros), Kind: parts[1], ID: uint(id)}, nil
+}
@@ -25,7 +25,14 @@ func (l locationService) GetEventLocationByOrgID(orgID uint) ([]models.Event, er
 		logs.Error(err)
 		return nil, errs.NewUnexpectedError()
 	}
-	return event, nil
+
+	visible := make([]models.Event, 0, len(event))
+	for _, e := range event {
+		if e.VisibleToPublic() {
+			visible = append(visible, e)
+		}
+	}
+	return visible, nil
 }
 
 func (l locationService) GetAllOrganizationLocation() ([]models.Organization, error) {
@@ -51,6 +58,9 @@ func (l locationService) GetOrganizationLocationByOrgID(orgID uint) (*models.Org
 		logs.Error(err)
 		return nil, errs.NewUnexpectedError()
 	}
+	if org.Status != models.OrgStatusApproved {
+		return nil, errs.NewNotFoundError("Organization not found")
+	}
 	return org, nil
 }
 
@@ -77,6 +87,9 @@ func (l locationService) GetEventLocationByEventID(eventID uint) (*models.Event,
 		logs.Error(err)
 		return nil, errs.NewUnexpectedError()
 	}
+	if !event.VisibleToPublic() {
+		return nil, errs.NewNotFoundError("Event not found")
+	}
 	return event, nil
 }
 
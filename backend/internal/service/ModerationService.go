@@ -0,0 +1,302 @@
+package service
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
+	"github.com/DAF-Bridge/asaiasa-Backend/logs"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const numberOfModeratedReports = 20
+
+type moderationService struct {
+	reportRepo     models.ReportRepository
+	openSearchRepo repository.OpenSearchRepository
+	notifications  NotificationPublisher
+}
+
+func NewModerationService(reportRepo models.ReportRepository, openSearchRepo repository.OpenSearchRepository,
+	notifications NotificationPublisher) ModerationService {
+	return moderationService{
+		reportRepo:     reportRepo,
+		openSearchRepo: openSearchRepo,
+		notifications:  notifications,
+	}
+}
+
+func (s moderationService) ListReports(status models.ReportStatus, targetType models.ReportTargetType, page int) (*dto.PaginatedReportsResponse, error) {
+	switch status {
+	case models.ReportStatusOpen, models.ReportStatusDismissed, models.ReportStatusActioned:
+	default:
+		return nil, errs.NewBadRequestError("invalid report status")
+	}
+	switch targetType {
+	case "", models.ReportTargetEvent, models.ReportTargetJob, models.ReportTargetOrganization, models.ReportTargetUser:
+	default:
+		return nil, errs.NewBadRequestError("invalid report target type")
+	}
+
+	reports, total, err := s.reportRepo.List(status, targetType, page, numberOfModeratedReports)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	responses := make([]dto.ReportResponse, 0, len(reports))
+	for _, report := range reports {
+		responses = append(responses, convertToReportResponse(report))
+	}
+
+	return &dto.PaginatedReportsResponse{Reports: responses, TotalReports: total}, nil
+}
+
+func (s moderationService) GetReport(reportID uint) (*dto.ReportDetailResponse, error) {
+	report, err := s.getReport(reportID)
+	if err != nil {
+		return nil, err
+	}
+
+	decisions, err := s.reportRepo.ListDecisions(report.TargetType, report.TargetID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	history := make([]dto.ModerationDecisionResponse, 0, len(decisions))
+	for _, decision := range decisions {
+		history = append(history, convertToModerationDecisionResponse(decision))
+	}
+
+	return &dto.ReportDetailResponse{ReportResponse: convertToReportResponse(*report), History: history}, nil
+}
+
+func (s moderationService) Dismiss(moderatorID uuid.UUID, reportID uint, note string) (*dto.ModerationDecisionResponse, error) {
+	report, err := s.getOpenReport(reportID)
+	if err != nil {
+		return nil, err
+	}
+
+	decision := newModerationDecision(moderatorID, models.ModerationDismiss, *report, note)
+	if err := s.reportRepo.Dismiss(decision); err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	response := convertToModerationDecisionResponse(*decision)
+	return &response, nil
+}
+
+func (s moderationService) Hide(moderatorID uuid.UUID, reportID uint, note string) (*dto.ModerationDecisionResponse, error) {
+	report, err := s.getOpenReport(reportID)
+	if err != nil {
+		return nil, err
+	}
+	if report.TargetType != models.ReportTargetEvent && report.TargetType != models.ReportTargetJob {
+		return nil, errs.NewBadRequestError("only events and jobs can be hidden; suspend the organization or the user instead")
+	}
+
+	orgID, err := s.targetOrganizationID(*report)
+	if err != nil {
+		return nil, err
+	}
+
+	decision := newModerationDecision(moderatorID, models.ModerationHide, *report, note)
+	decision.OrganizationID = &orgID
+	if err := s.reportRepo.Hide(decision); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError(string(report.TargetType) + " not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	// The CDC consumer also drops hidden content when it sees the update; removing it here
+	// takes it out of search without waiting for the pipeline
+	id, _ := strconv.ParseUint(report.TargetID, 10, 64)
+	if report.TargetType == models.ReportTargetEvent {
+		err = s.openSearchRepo.DeleteEvent(dto.EventDocument{ID: uint(id)})
+	} else {
+		err = s.openSearchRepo.DeleteJob(dto.JobDocument{ID: uint(id)})
+	}
+	if err != nil {
+		logs.Error("Failed to remove hidden content from OpenSearch, but it was hidden in the database: " + err.Error())
+	}
+
+	s.notifyOrganization(orgID, models.NotificationMessage{
+		Type:  models.NotificationContentModerated,
+		Title: "A " + string(report.TargetType) + " of your organization was taken down after a report",
+		Body:  decision.Note,
+	}, uint(id))
+
+	response := convertToModerationDecisionResponse(*decision)
+	return &response, nil
+}
+
+func (s moderationService) SuspendOrganization(moderatorID uuid.UUID, reportID uint, note string) (*dto.ModerationDecisionResponse, error) {
+	report, err := s.getOpenReport(reportID)
+	if err != nil {
+		return nil, err
+	}
+	if report.TargetType == models.ReportTargetUser {
+		return nil, errs.NewBadRequestError("a user profile has no organization; suspend the user instead")
+	}
+	if strings.TrimSpace(note) == "" {
+		return nil, errs.NewBadRequestError("a note is required to suspend an organization")
+	}
+
+	orgID, err := s.targetOrganizationID(*report)
+	if err != nil {
+		return nil, err
+	}
+
+	decision := newModerationDecision(moderatorID, models.ModerationSuspendOrg, *report, note)
+	decision.OrganizationID = &orgID
+	if err := s.reportRepo.SuspendOrganization(decision); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("organization not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if err := s.openSearchRepo.DeleteOrganization(orgID); err != nil {
+		logs.Error("Failed to remove suspended organization from OpenSearch, but it was suspended in the database: " + err.Error())
+	}
+
+	s.notifyOrganization(orgID, models.NotificationMessage{
+		Type:  models.NotificationContentModerated,
+		Title: "Your organization was suspended after a report",
+		Body:  decision.Note,
+	}, orgID)
+
+	response := convertToModerationDecisionResponse(*decision)
+	return &response, nil
+}
+
+func (s moderationService) Reinstate(moderatorID uuid.UUID, reportID uint, note string) (*dto.ModerationDecisionResponse, error) {
+	report, err := s.getReport(reportID)
+	if err != nil {
+		return nil, err
+	}
+	if report.Status != models.ReportStatusActioned || report.Decision == nil {
+		return nil, errs.NewConflictError("only an actioned report can be reinstated")
+	}
+
+	decision := newModerationDecision(moderatorID, models.ModerationReinstate, *report, note)
+	decision.OrganizationID = report.Decision.OrganizationID
+
+	var message models.NotificationMessage
+	var targetID uint
+	switch report.Decision.Action {
+	case models.ModerationHide:
+		err = s.reportRepo.Unhide(decision)
+		id, _ := strconv.ParseUint(report.TargetID, 10, 64)
+		targetID = uint(id)
+		message = models.NotificationMessage{
+			Type:  models.NotificationContentModerated,
+			Title: "A " + string(report.TargetType) + " of your organization taken down after a report was reinstated",
+			Body:  decision.Note,
+		}
+	case models.ModerationSuspendOrg:
+		// Suspensions decided before the previous status was recorded restore approved, as they did
+		status := report.Decision.OrgStatusBefore
+		if status == "" {
+			status = models.OrgStatusApproved
+		}
+		err = s.reportRepo.ReinstateOrganization(decision, status)
+		if decision.OrganizationID != nil {
+			targetID = *decision.OrganizationID
+		}
+		message = models.NotificationMessage{
+			Type:  models.NotificationContentModerated,
+			Title: "Your organization suspended after a report was reinstated",
+			Body:  decision.Note,
+		}
+	default:
+		return nil, errs.NewConflictError("the decision on this report cannot be reinstated")
+	}
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewConflictError(string(report.TargetType) + " is not hidden or suspended anymore")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if decision.OrganizationID != nil {
+		s.notifyOrganization(*decision.OrganizationID, message, targetID)
+	}
+
+	response := convertToModerationDecisionResponse(*decision)
+	return &response, nil
+}
+
+func (s moderationService) getReport(reportID uint) (*models.Report, error) {
+	report, err := s.reportRepo.GetByID(reportID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("report not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return report, nil
+}
+
+func (s moderationService) getOpenReport(reportID uint) (*models.Report, error) {
+	report, err := s.getReport(reportID)
+	if err != nil {
+		return nil, err
+	}
+	if report.Status != models.ReportStatusOpen {
+		return nil, errs.NewConflictError("report has already been resolved")
+	}
+
+	return report, nil
+}
+
+func (s moderationService) targetOrganizationID(report models.Report) (uint, error) {
+	orgID, err := s.reportRepo.TargetOrganizationID(report.TargetType, report.TargetID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, errs.NewNotFoundError(string(report.TargetType) + " not found")
+		}
+
+		logs.Error(err)
+		return 0, errs.NewUnexpectedError()
+	}
+
+	return orgID, nil
+}
+
+func (s moderationService) notifyOrganization(orgID uint, message models.NotificationMessage, targetID uint) {
+	if s.notifications == nil {
+		return
+	}
+
+	message.OrganizationID = &orgID
+	message.TargetID = &targetID
+	s.notifications.PublishToOrganizationMembers(orgID, message)
+}
+
+func newModerationDecision(moderatorID uuid.UUID, action models.ModerationAction, report models.Report, note string) *models.ModerationDecision {
+	return &models.ModerationDecision{
+		ModeratorID: moderatorID,
+		Action:      action,
+		TargetType:  report.TargetType,
+		TargetID:    report.TargetID,
+		Note:        strings.TrimSpace(note),
+	}
+}
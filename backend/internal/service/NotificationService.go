@@ -0,0 +1,161 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/logs"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const numberOfNotification = 20
+
+type notificationService struct {
+	notificationRepo models.NotificationRepository
+	roleRepo         models.RoleRepository
+	followRepo       models.OrganizationFollowRepository
+	live             LiveEventPublisher
+}
+
+func NewNotificationService(notificationRepo models.NotificationRepository) NotificationService {
+	return notificationService{notificationRepo: notificationRepo}
+}
+
+func NewNotificationPublisher(notificationRepo models.NotificationRepository, roleRepo models.RoleRepository,
+	followRepo models.OrganizationFollowRepository, live LiveEventPublisher) NotificationPublisher {
+	return notificationService{
+		notificationRepo: notificationRepo,
+		roleRepo:         roleRepo,
+		followRepo:       followRepo,
+		live:             live,
+	}
+}
+
+func (s notificationService) ListNotifications(userID uuid.UUID, unreadOnly bool, page int) (*dto.NotificationListResponse, error) {
+	notifications, err := s.notificationRepo.ListByUserID(userID, unreadOnly, page, numberOfNotification)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	unread, err := s.notificationRepo.CountUnread(userID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	responses := make([]dto.NotificationResponse, 0, len(notifications))
+	for _, notification := range notifications {
+		responses = append(responses, convertToNotificationResponse(notification))
+	}
+
+	return &dto.NotificationListResponse{Notifications: responses, UnreadCount: unread}, nil
+}
+
+func (s notificationService) GetUnreadCount(userID uuid.UUID) (*dto.UnreadCountResponse, error) {
+	unread, err := s.notificationRepo.CountUnread(userID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return &dto.UnreadCountResponse{UnreadCount: unread}, nil
+}
+
+func (s notificationService) MarkRead(userID uuid.UUID, id uint) error {
+	if err := s.notificationRepo.MarkRead(userID, id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("notification not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s notificationService) MarkAllRead(userID uuid.UUID) error {
+	if _, err := s.notificationRepo.MarkAllRead(userID); err != nil {
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s notificationService) Publish(recipients []uuid.UUID, message models.NotificationMessage) {
+	notifications := make([]models.Notification, 0, len(recipients))
+	seen := make(map[uuid.UUID]bool, len(recipients))
+	for _, userID := range recipients {
+		if seen[userID] {
+			continue
+		}
+		seen[userID] = true
+		notifications = append(notifications, models.NewNotification(userID, message))
+	}
+
+	if err := s.notificationRepo.CreateMany(notifications); err != nil {
+		logs.Error("Failed to publish " + string(message.Type) + " notifications: " + err.Error())
+		return
+	}
+
+	if s.live == nil {
+		return
+	}
+	eventName := StreamEventNotification
+	if message.Type == models.NotificationInvitation {
+		eventName = StreamEventInvitation
+	}
+	for _, notification := range notifications {
+		s.live.SendToUsers([]uuid.UUID{notification.UserID}, eventName, convertToNotificationResponse(notification))
+	}
+}
+
+func (s notificationService) PublishToOrganizationMembers(orgID uint, message models.NotificationMessage) {
+	roles, err := s.roleRepo.FindByOrganizationID(orgID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Error("Failed to look up members to notify: " + err.Error())
+		return
+	}
+
+	recipients := make([]uuid.UUID, 0, len(roles))
+	for _, role := range roles {
+		recipients = append(recipients, role.UserID)
+	}
+
+	s.Publish(recipients, message)
+}
+
+func (s notificationService) PublishToOrganizationFollowers(orgID uint, message models.NotificationMessage) {
+	recipients, err := s.followRepo.ListFollowerIDs(orgID)
+	if err != nil {
+		logs.Error("Failed to look up followers to notify: " + err.Error())
+		return
+	}
+
+	s.Publish(recipients, message)
+}
+
+func convertToNotificationResponse(notification models.Notification) dto.NotificationResponse {
+	response := dto.NotificationResponse{
+		ID:             notification.ID,
+		Type:           string(notification.Type),
+		Title:          notification.Title,
+		Body:           notification.Body,
+		OrganizationID: notification.OrganizationID,
+		TargetID:       notification.TargetID,
+		Read:           notification.ReadAt != nil,
+		CreatedAt:      notification.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	if notification.ReadAt != nil {
+		readAt := notification.ReadAt.UTC().Format(time.RFC3339)
+		response.ReadAt = &readAt
+	}
+
+	return response
+}
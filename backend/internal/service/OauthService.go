@@ -1,53 +1,87 @@
 package service
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/DAF-Bridge/asaiasa-Backend/errs"
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
 	"github.com/DAF-Bridge/asaiasa-Backend/logs"
+	"github.com/DAF-Bridge/asaiasa-Backend/pkg/oauthprovider"
 	"github.com/DAF-Bridge/asaiasa-Backend/utils"
-	"github.com/golang-jwt/jwt/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
 )
 
 type OauthService struct {
-	userRepo    repository.UserRepository
-	profileRepo *repository.ProfileRepository
-	jwtSecret   string
+	userRepo       repository.UserRepository
+	identityRepo   models.UserIdentityRepository
+	sessionService SessionService
 }
 
-func NewOauthService(userRepo repository.UserRepository, profileRepo *repository.ProfileRepository, jwtSecret string) *OauthService {
-	return &OauthService{userRepo: userRepo, profileRepo: profileRepo, jwtSecret: jwtSecret}
+func NewOauthService(userRepo repository.UserRepository, identityRepo models.UserIdentityRepository, sessionService SessionService) *OauthService {
+	return &OauthService{userRepo: userRepo, identityRepo: identityRepo, sessionService: sessionService}
 }
 
-func (s *OauthService) AuthenticateUser(name, email, provider, providerID string) (string, error) {
+// AuthenticateUser signs in through an external provider. Users are looked up by their
+// linked identity first; an unknown identity whose verified email matches an existing
+// account with a verified email is linked to that account rather than creating a duplicate.
+func (s *OauthService) AuthenticateUser(info oauthprovider.UserInfo, client SessionClient) (*AuthTokens, error) {
+	provider, providerID, name, email := info.Provider, info.ProviderID, info.Name, info.Email
 
-	// Start a new transaction
-	tx := s.userRepo.BeginTransaction()
-
-	// Always defer rollback in case something goes wrong
-	defer func() {
-		if r := recover(); r != nil {
-			tx.Rollback() // Rollback in case of a panic
-		}
-	}()
+	identity, err := s.identityRepo.GetByProvider(models.Provider(provider), providerID)
+	if err == nil {
+		return s.sessionService.Issue(&identity.User, client)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
 
-	user := &models.User{
-		Name:       name,
-		Email:      email,
-		Provider:   models.Provider(provider),
-		ProviderID: providerID,
+	if email == "" {
+		return nil, errs.NewBadRequestError(fmt.Sprintf("the %s account did not share an email address", provider))
 	}
 
-	// check if email is already taken
 	if existedUser, err := s.userRepo.FindByEmail(email); err == nil {
-		user.ID = existedUser.ID
-		token, err := s.generateJWT(user)
+		// An unverified address proves nothing about who owns the account
+		if !info.EmailVerified {
+			return nil, errs.NewConflictError(fmt.Sprintf("an account with this email already exists, log in and link your %s account from your profile", provider))
+		}
+		// Nor does it on the account side: whoever registered it may not own the address, and
+		// linking would let them keep signing in with their password
+		if existedUser.EmailVerifiedAt == nil {
+			return nil, errs.NewConflictError(fmt.Sprintf("an account with this email already exists but its email is not verified, verify it and log in to link your %s account", provider))
+		}
+
+		err := s.identityRepo.Create(&models.UserIdentity{
+			UserID:     existedUser.ID,
+			Provider:   models.Provider(provider),
+			ProviderID: providerID,
+			Email:      email,
+		})
 		if err != nil {
-			return "", errs.NewUnexpectedError()
+			var pqErr *pgconn.PgError
+			if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+				return nil, errs.NewConflictError(fmt.Sprintf("another %s account is already linked to this user", provider))
+			}
+
+			logs.Error(err)
+			return nil, errs.NewUnexpectedError()
 		}
-		return token, nil
+
+		return s.sessionService.Issue(existedUser, client)
+	}
+
+	user := &models.User{
+		Name:  name,
+		Email: email,
+	}
+	// The provider has already verified the address
+	if info.EmailVerified {
+		verifiedAt := time.Now()
+		user.EmailVerifiedAt = &verifiedAt
 	}
 
 	fname, lname := utils.SeparateName(name)
@@ -59,43 +93,15 @@ func (s *OauthService) AuthenticateUser(name, email, provider, providerID string
 		Phone:     "",
 	}
 
-	// Start the transaction for creating the user and profile
-	if err := s.userRepo.Create(user); err != nil {
-		tx.Rollback() // Rollback if user creation fails
-		logs.Error("Failed to create user")
-		return "", errs.NewConflictError(err.Error())
-	}
-
-	profile.UserID = user.ID
-
-	// Create the profile
-	if err := s.profileRepo.Create(profile); err != nil {
-		tx.Rollback() // Rollback if profile creation fails
-		logs.Error("Failed to create profile")
-		return "", errs.NewConflictError(err.Error())
-	}
-
-	// Commit the transaction if everything is successful
-	if err := tx.Commit().Error; err != nil {
-		tx.Rollback() // Rollback if commit fails
-		logs.Error("Failed to commit create user transaction")
-		return "", errs.NewUnexpectedError()
-	}
-
-	// Generate JWT
-	token, err := s.generateJWT(user)
-	if err != nil {
-		return "", errs.NewUnexpectedError()
+	// Create the user, its profile and the provider account together
+	if err := s.identityRepo.CreateWithUser(user, profile, &models.UserIdentity{
+		Provider:   models.Provider(provider),
+		ProviderID: providerID,
+		Email:      email,
+	}); err != nil {
+		logs.Error("Failed to create user: " + err.Error())
+		return nil, errs.NewConflictError(err.Error())
 	}
-	return token, nil
-}
 
-func (s *OauthService) generateJWT(user *models.User) (string, error) {
-	// Generate JWT
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"user_id": user.ID,
-		"email":   user.Email,
-		"exp":     time.Now().Add(time.Hour * 24 * 7).Unix(), // 7 days
-	})
-	return token.SignedString([]byte(s.jwtSecret))
+	return s.sessionService.Issue(user, client)
 }
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
+	"github.com/DAF-Bridge/asaiasa-Backend/logs"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const numberOfReviewedOrganization = 20
+
+type organizationReviewService struct {
+	reviewRepo    models.OrganizationReviewRepository
+	orgRepo       repository.OrganizationRepository
+	roleRepo      models.RoleRepository
+	notifications NotificationPublisher
+}
+
+func NewOrganizationReviewService(reviewRepo models.OrganizationReviewRepository, orgRepo repository.OrganizationRepository,
+	roleRepo models.RoleRepository, notifications NotificationPublisher) OrganizationReviewService {
+	return organizationReviewService{
+		reviewRepo:    reviewRepo,
+		orgRepo:       orgRepo,
+		roleRepo:      roleRepo,
+		notifications: notifications,
+	}
+}
+
+func (s organizationReviewService) ListOrganizations(status string, page uint) ([]dto.OrganizationResponse, error) {
+	if !isOrganizationStatus(status) {
+		return nil, errs.NewBadRequestError("invalid organization status")
+	}
+
+	orgs, err := s.reviewRepo.ListOrganizationsByStatus(status, page, numberOfReviewedOrganization)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	responses := make([]dto.OrganizationResponse, 0, len(orgs))
+	for _, org := range orgs {
+		responses = append(responses, ConvertToOrgResponse(org))
+	}
+
+	return responses, nil
+}
+
+func (s organizationReviewService) Review(reviewerID uuid.UUID, orgID uint, decision string, reason string) (*dto.OrganizationReviewResponse, error) {
+	reason = strings.TrimSpace(reason)
+	if decision != models.OrgStatusApproved && reason == "" {
+		return nil, errs.NewBadRequestError("a reason is required to reject or request changes")
+	}
+
+	org, err := s.orgRepo.GetByOrgID(orgID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("organization not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	review := &models.OrganizationReview{
+		OrganizationID: orgID,
+		ReviewerID:     reviewerID,
+		Decision:       decision,
+		Reason:         reason,
+	}
+	if err := s.reviewRepo.Create(review, models.OrgStatusPending); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewConflictError("organization is not waiting for review")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	s.notifyOwners(*org, *review)
+
+	response := convertToOrganizationReviewResponse(*review)
+	return &response, nil
+}
+
+func (s organizationReviewService) ListReviews(orgID uint) ([]dto.OrganizationReviewResponse, error) {
+	reviews, err := s.reviewRepo.ListByOrganizationID(orgID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	responses := make([]dto.OrganizationReviewResponse, 0, len(reviews))
+	for _, review := range reviews {
+		responses = append(responses, convertToOrganizationReviewResponse(review))
+	}
+
+	return responses, nil
+}
+
+func (s organizationReviewService) notifyOwners(org models.Organization, review models.OrganizationReview) {
+	owners, err := s.roleRepo.FindByRoleNameAndOrganizationID("owner", org.ID)
+	if err != nil {
+		logs.Error("Failed to look up owners to notify of the review: " + err.Error())
+		return
+	}
+
+	recipients := make([]uuid.UUID, 0, len(owners))
+	for _, owner := range owners {
+		recipients = append(recipients, owner.UserID)
+	}
+
+	var title string
+	switch review.Decision {
+	case models.OrgStatusApproved:
+		title = org.Name + " is approved and now listed publicly"
+	case models.OrgStatusRejected:
+		title = org.Name + " was not approved"
+	default:
+		title = "Changes are needed before " + org.Name + " can be approved"
+	}
+
+	s.notifications.Publish(recipients, models.NotificationMessage{
+		Type:           models.NotificationOrgReviewed,
+		Title:          title,
+		Body:           review.Reason,
+		OrganizationID: &review.OrganizationID,
+		TargetID:       &review.ID,
+	})
+}
+
+func isOrganizationStatus(status string) bool {
+	switch status {
+	case models.OrgStatusPending, models.OrgStatusApproved, models.OrgStatusRejected, models.OrgStatusChangesRequested,
+		models.OrgStatusSuspended:
+		return true
+	}
+	return false
+}
+
+func convertToOrganizationReviewResponse(review models.OrganizationReview) dto.OrganizationReviewResponse {
+	return dto.OrganizationReviewResponse{
+		ID:             review.ID,
+		OrganizationID: review.OrganizationID,
+		ReviewerID:     review.ReviewerID.String(),
+		Decision:       review.Decision,
+		Reason:         review.Reason,
+		CreatedAt:      review.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
@@ -26,17 +26,23 @@ const numberOfOrganization uint = 36
 const numberOfJob uint = 4
 
 type organizationService struct {
-	repo   repository.OrganizationRepository
-	casbin repository.EnforcerRoleRepository
-	S3     *infrastructure.S3Uploader
+	repo          repository.OrganizationRepository
+	casbin        repository.EnforcerRoleRepository
+	DB            *gorm.DB
+	OS            *opensearch.Client
+	S3            *infrastructure.S3Uploader
+	notifications NotificationPublisher
 }
 
 func NewOrganizationService(repo repository.OrganizationRepository, casbin repository.EnforcerRoleRepository,
-	S3 *infrastructure.S3Uploader) OrganizationService {
+	db *gorm.DB, os *opensearch.Client, S3 *infrastructure.S3Uploader, notifications NotificationPublisher) OrganizationService {
 	return organizationService{
-		repo:   repo,
-		casbin: casbin,
-		S3:     S3,
+		repo:          repo,
+		casbin:        casbin,
+		DB:            db,
+		OS:            os,
+		S3:            S3,
+		notifications: notifications,
 	}
 }
 
@@ -167,6 +173,15 @@ func (s organizationService) CreateOrganization(userID uuid.UUID, org dto.Organi
 }
 
 func (s organizationService) GetOrganizationByID(id uint) (*dto.OrganizationResponse, error) {
+	return s.getOrganizationByID(id, false)
+}
+
+func (s organizationService) GetPublicOrganizationByID(id uint) (*dto.OrganizationResponse, error) {
+	return s.getOrganizationByID(id, true)
+}
+
+// getOrganizationByID gets an organization, not found unless approved when public is set
+func (s organizationService) getOrganizationByID(id uint, public bool) (*dto.OrganizationResponse, error) {
 	org, err := s.repo.GetByOrgID(id)
 
 	if err != nil {
@@ -177,10 +192,16 @@ func (s organizationService) GetOrganizationByID(id uint) (*dto.OrganizationResp
 		logs.Error(err)
 		return nil, errs.NewUnexpectedError()
 	}
+	if public && org.Status != models.OrgStatusApproved {
+		return nil, errs.NewNotFoundError("organization not found")
+	}
 
-	resOrgs := ConvertToOrgResponse(*org)
+	resOrgs := []dto.OrganizationResponse{ConvertToOrgResponse(*org)}
+	if err := s.withFollowerCounts(resOrgs); err != nil {
+		return nil, err
+	}
 
-	return &resOrgs, nil
+	return &resOrgs[0], nil
 }
 
 func (s organizationService) GetPaginateOrganization(page uint) ([]dto.OrganizationResponse, error) {
@@ -199,10 +220,38 @@ func (s organizationService) GetPaginateOrganization(page uint) ([]dto.Organizat
 	for _, org := range orgs {
 		orgsResponses = append(orgsResponses, ConvertToOrgResponse(org))
 	}
+	if err := s.withFollowerCounts(orgsResponses); err != nil {
+		return nil, err
+	}
 
 	return orgsResponses, nil
 }
 
+func (s organizationService) SearchOrganizations(query dto.SearchOrganizationQuery, page int, Offset int) (dto.SearchOrganizationResponse, error) {
+	// Organizations still in review are never searchable
+	query.Status = models.OrgStatusApproved
+	orgsRes, err := search.SearchOrganizations(s.OS, query, page, Offset)
+	if err != nil {
+		if len(orgsRes.Organizations) == 0 {
+			return dto.SearchOrganizationResponse{}, errs.NewNotFoundError("No search results found")
+		}
+
+		return dto.SearchOrganizationResponse{}, errs.NewUnexpectedError()
+	}
+
+	return orgsRes, nil
+}
+
+func (s organizationService) SyncOrganizations() error {
+	err := sync.SyncOrganizationsToOpenSearch(s.DB, s.OS)
+	if err != nil {
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
 func (s organizationService) ListAllOrganizations() ([]dto.OrganizationResponse, error) {
 
 	orgs, err := s.repo.GetAllOrganizations()
@@ -220,6 +269,9 @@ func (s organizationService) ListAllOrganizations() ([]dto.OrganizationResponse,
 	for _, org := range orgs {
 		orgsResponses = append(orgsResponses, ConvertToOrgResponse(org))
 	}
+	if err := s.withFollowerCounts(orgsResponses); err != nil {
+		return nil, err
+	}
 
 	return orgsResponses, nil
 }
@@ -337,9 +389,32 @@ func (s organizationService) UpdateOrganization(orgID uint, org dto.Organization
 	}
 
 	updatedOrg.PicUrl = newOrg.PicUrl
-	resOrgs := ConvertToOrgResponse(*updatedOrg)
+	resOrgs := []dto.OrganizationResponse{ConvertToOrgResponse(*updatedOrg)}
+	if err := s.withFollowerCounts(resOrgs); err != nil {
+		return nil, err
+	}
+
+	return &resOrgs[0], nil
+}
+
+// withFollowerCounts fills in the follower count of each organization in orgs
+func (s organizationService) withFollowerCounts(orgs []dto.OrganizationResponse) error {
+	ids := make([]uint, 0, len(orgs))
+	for _, org := range orgs {
+		ids = append(ids, org.ID)
+	}
+
+	counts, err := s.repo.CountFollowers(ids)
+	if err != nil {
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	for i := range orgs {
+		orgs[i].FollowerCount = counts[orgs[i].ID]
+	}
 
-	return &resOrgs, nil
+	return nil
 }
 
 func (s organizationService) UpdateOrganizationPicture(id uint, picURL string) error {
@@ -371,7 +446,25 @@ func (s organizationService) UpdateOrganizationBackgroundPicture(id uint, picURL
 }
 
 func (s organizationService) UpdateOrganizationStatus(orgID uint, status string) error {
-	err := s.repo.UpdateOrganizationStatus(orgID, status)
+	org, err := s.repo.GetByOrgID(orgID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("organization not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	// Members can only send their organization back for review; system admins decide the rest
+	if status != models.OrgStatusPending {
+		return errs.NewBadRequestError("organization status can only be set to " + models.OrgStatusPending + " to ask for another review")
+	}
+	if org.Status != models.OrgStatusRejected && org.Status != models.OrgStatusChangesRequested {
+		return errs.NewBadRequestError("organization is not waiting for changes")
+	}
+
+	err = s.repo.UpdateOrganizationStatus(orgID, status)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return errs.NewNotFoundError("organization not found")
@@ -381,6 +474,15 @@ func (s organizationService) UpdateOrganizationStatus(orgID uint, status string)
 		return errs.NewUnexpectedError()
 	}
 
+	if s.notifications != nil && org.Status != status {
+		s.notifications.PublishToOrganizationMembers(orgID, models.NotificationMessage{
+			Type:           models.NotificationOrgStatusChanged,
+			Title:          org.Name + " is now " + status,
+			Body:           "The status of " + org.Name + " changed from " + org.Status + " to " + status + ".",
+			OrganizationID: &orgID,
+		})
+	}
+
 	return nil
 }
 
@@ -585,6 +687,7 @@ func (s orgOpenJobService) NewJob(orgID uint, req dto.JobRequest) error {
 	}
 
 	job := ConvertToJobRequest(orgID, req, categories)
+	job.PublishedAt = models.PublishedAtFor(nil, job.Status)
 	if err = s.jobRepo.CreateJob(orgID, &job); err != nil {
 		logs.Error(err)
 		return errs.NewUnexpectedError()
@@ -757,7 +860,16 @@ func (s orgOpenJobService) ListAllJobs() ([]dto.JobResponses, error) {
 }
 
 func (s orgOpenJobService) GetAllJobsByOrgID(OrgId uint) ([]dto.JobResponses, error) {
-	jobs, err := s.jobRepo.GetAllJobsByOrgID(OrgId)
+	return s.listJobsByOrgID(OrgId, true)
+}
+
+func (s orgOpenJobService) GetAllManagedJobsByOrgID(orgID uint) ([]dto.JobResponses, error) {
+	return s.listJobsByOrgID(orgID, false)
+}
+
+// listJobsByOrgID lists the jobs of an organization, only those VisibleToPublic when public is set
+func (s orgOpenJobService) listJobsByOrgID(orgID uint, public bool) ([]dto.JobResponses, error) {
+	jobs, err := s.jobRepo.GetAllJobsByOrgID(orgID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errs.NewNotFoundError("jobs not found")
@@ -770,6 +882,9 @@ func (s orgOpenJobService) GetAllJobsByOrgID(OrgId uint) ([]dto.JobResponses, er
 	var jobsResponse []dto.JobResponses
 
 	for _, job := range jobs {
+		if public && !job.VisibleToPublic() {
+			continue
+		}
 		jobResponse := ConvertToJobResponse(job)
 		jobsResponse = append(jobsResponse, jobResponse)
 	}
@@ -787,6 +902,9 @@ func (s orgOpenJobService) GetJobByID(jobID uint) (*dto.JobResponses, error) {
 		logs.Error(err)
 		return nil, errs.NewUnexpectedError()
 	}
+	if !job.VisibleToPublic() {
+		return nil, errs.NewNotFoundError("job not found")
+	}
 
 	JobResponse := ConvertToJobResponse(*job)
 
@@ -794,6 +912,15 @@ func (s orgOpenJobService) GetJobByID(jobID uint) (*dto.JobResponses, error) {
 }
 
 func (s orgOpenJobService) GetJobByIDwithOrgID(orgID uint, jobID uint) (*dto.JobResponses, error) {
+	return s.getJobByIDwithOrgID(orgID, jobID, true)
+}
+
+func (s orgOpenJobService) GetManagedJobByID(orgID uint, jobID uint) (*dto.JobResponses, error) {
+	return s.getJobByIDwithOrgID(orgID, jobID, false)
+}
+
+// getJobByIDwithOrgID gets a job of an organization, not found unless VisibleToPublic when public is set
+func (s orgOpenJobService) getJobByIDwithOrgID(orgID uint, jobID uint, public bool) (*dto.JobResponses, error) {
 	job, err := s.jobRepo.GetJobByIDWithOrgID(orgID, jobID)
 
 	if err != nil {
@@ -804,6 +931,9 @@ func (s orgOpenJobService) GetJobByIDwithOrgID(orgID uint, jobID uint) (*dto.Job
 		logs.Error(err)
 		return nil, errs.NewUnexpectedError()
 	}
+	if public && !job.VisibleToPublic() {
+		return nil, errs.NewNotFoundError("job not found")
+	}
 
 	JobResponse := ConvertToJobResponse(*job)
 
@@ -863,6 +993,7 @@ func (s orgOpenJobService) UpdateJob(orgID uint, jobID uint, dto dto.JobRequest)
 
 	job := ConvertToJobRequest(orgID, dto, categories)
 	job.ID = existJob.ID
+	job.PublishedAt = models.PublishedAtFor(existJob.PublishedAt, job.Status)
 
 	// Convert prerequisites DTO to models
 	var updatedPrerequisites []models.Prerequisite
@@ -883,12 +1014,14 @@ func (s orgOpenJobService) UpdateJob(orgID uint, jobID uint, dto dto.JobRequest)
 		return nil, errs.NewUnexpectedError()
 	}
 
-	// Convert to JobDocument and index in OpenSearch
-	jobDoc := convertToJobDocument(*updatedJob)
-	err = s.openSearchRepo.CreateOrUpdateJob(jobDoc)
-	if err != nil {
-		logs.Error(err)
-		logs.Error("Failed to update job in OpenSearch, but database operation was successful")
+	// Convert to JobDocument and index in OpenSearch, unless the job was hidden by moderation
+	if updatedJob.HiddenAt == nil {
+		jobDoc := convertToJobDocument(*updatedJob)
+		err = s.openSearchRepo.CreateOrUpdateJob(jobDoc)
+		if err != nil {
+			logs.Error(err)
+			logs.Error("Failed to update job in OpenSearch, but database operation was successful")
+		}
 	}
 
 	updatedJob.PicUrl = job.PicUrl
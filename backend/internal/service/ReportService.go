@@ -0,0 +1,100 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/logs"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type reportService struct {
+	reportRepo models.ReportRepository
+}
+
+func NewReportService(reportRepo models.ReportRepository) ReportService {
+	return reportService{reportRepo: reportRepo}
+}
+
+func (s reportService) CreateReport(reporterID uuid.UUID, req dto.ReportRequest) (*dto.ReportResponse, error) {
+	targetType := models.ReportTargetType(req.TargetType)
+	targetID := strings.TrimSpace(req.TargetID)
+	if targetType == models.ReportTargetUser {
+		// The same user must always be stored under the same ID for the duplicate check to work
+		userID, err := uuid.Parse(targetID)
+		if err != nil {
+			return nil, errs.NewNotFoundError("user not found")
+		}
+		if userID == reporterID {
+			return nil, errs.NewBadRequestError("you cannot report yourself")
+		}
+		targetID = userID.String()
+	}
+
+	exists, err := s.reportRepo.TargetExists(targetType, targetID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+	if !exists {
+		return nil, errs.NewNotFoundError(req.TargetType + " not found")
+	}
+
+	report := &models.Report{
+		ReporterID: reporterID,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Reason:     req.Reason,
+		Details:    strings.TrimSpace(req.Details),
+		Status:     models.ReportStatusOpen,
+	}
+	if err := s.reportRepo.Create(report); err != nil {
+		var pqErr *pgconn.PgError
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return nil, errs.NewConflictError("you have already reported this " + req.TargetType)
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	response := convertToReportResponse(*report)
+	return &response, nil
+}
+
+func convertToReportResponse(report models.Report) dto.ReportResponse {
+	response := dto.ReportResponse{
+		ID:         report.ID,
+		ReporterID: report.ReporterID.String(),
+		TargetType: string(report.TargetType),
+		TargetID:   report.TargetID,
+		Reason:     report.Reason,
+		Details:    report.Details,
+		Status:     string(report.Status),
+		CreatedAt:  report.CreatedAt.Format(time.RFC3339),
+	}
+	if report.Decision != nil {
+		decision := convertToModerationDecisionResponse(*report.Decision)
+		response.Decision = &decision
+	}
+
+	return response
+}
+
+func convertToModerationDecisionResponse(decision models.ModerationDecision) dto.ModerationDecisionResponse {
+	return dto.ModerationDecisionResponse{
+		ID:             decision.ID,
+		ModeratorID:    decision.ModeratorID.String(),
+		Action:         string(decision.Action),
+		TargetType:     string(decision.TargetType),
+		TargetID:       decision.TargetID,
+		OrganizationID: decision.OrganizationID,
+		Note:           decision.Note,
+		CreatedAt:      decision.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -24,6 +24,7 @@ type RoleWithDomainService struct {
 	organizationRepository repository.OrganizationRepository
 	inviteTokenRepository  models.InviteTokenRepository
 	inviteMailRepository   repository.MailRepository
+	notificationPublisher  NotificationPublisher
 }
 
 func NewRoleWithDomainService(dbRoleRepository models.RoleRepository,
@@ -31,9 +32,10 @@ func NewRoleWithDomainService(dbRoleRepository models.RoleRepository,
 	userRepository repository.UserRepository,
 	organizationRepository repository.OrganizationRepository,
 	inviteTokenRepository models.InviteTokenRepository,
-	inviteMailRepository repository.MailRepository) RoleService {
+	inviteMailRepository repository.MailRepository,
+	notificationPublisher NotificationPublisher) RoleService {
 	if dbRoleRepository == nil || enforcerRoleRepository == nil || userRepository == nil ||
-		organizationRepository == nil || inviteTokenRepository == nil || inviteMailRepository == nil {
+		organizationRepository == nil || inviteTokenRepository == nil || inviteMailRepository == nil || notificationPublisher == nil {
 		log.Fatal("One or more dependencies are nil")
 	}
 	roleService := RoleWithDomainService{
@@ -42,7 +44,8 @@ func NewRoleWithDomainService(dbRoleRepository models.RoleRepository,
 		userRepository:         userRepository,
 		organizationRepository: organizationRepository,
 		inviteTokenRepository:  inviteTokenRepository,
-		inviteMailRepository:   inviteMailRepository}
+		inviteMailRepository:   inviteMailRepository,
+		notificationPublisher:  notificationPublisher}
 	//_,_=roleService.UpdateRoleToEnforcer()
 	ok, err := roleService.UpdateRoleToEnforcer()
 	if err != nil {
@@ -216,6 +219,13 @@ func (r RoleWithDomainService) Invitation(inviterUserID uuid.UUID, invitedEmail
 		return false, errs.NewUnexpectedError()
 	}
 
+	r.notificationPublisher.Publish([]uuid.UUID{invitedUser.ID}, models.NotificationMessage{
+		Type:           models.NotificationInvitation,
+		Title:          "You are invited to join " + org.Name,
+		Body:           inviterUser.Name + " invited you to help manage " + org.Name + ". Accept the invitation from the email we sent you.",
+		OrganizationID: &orgID,
+	})
+
 	return true, nil
 }
 
@@ -347,10 +357,28 @@ func (r RoleWithDomainService) EditRole(editorUserID uuid.UUID, targetUserID uui
 		logs.Error("Failed to update role in enforcer")
 		return false, errs.NewUnexpectedError()
 	}
+
+	if editorUserID != targetUserID {
+		r.notifyRoleChanged(targetUserID, orgID, role)
+	}
 	return true, nil
 
 }
 
+func (r RoleWithDomainService) notifyRoleChanged(userID uuid.UUID, orgID uint, role string) {
+	orgName := "the organization"
+	if org, err := r.organizationRepository.GetByOrgID(orgID); err == nil {
+		orgName = org.Name
+	}
+
+	r.notificationPublisher.Publish([]uuid.UUID{userID}, models.NotificationMessage{
+		Type:           models.NotificationRoleChanged,
+		Title:          "Your role in " + orgName + " changed",
+		Body:           "You are now a " + role + " of " + orgName + ".",
+		OrganizationID: &orgID,
+	})
+}
+
 func (r RoleWithDomainService) DeleteMember(editorUserID uuid.UUID, targetUserID uuid.UUID, orgID uint) (bool, error) {
 	//check number owner
 	owners, err := r.dbRoleRepository.FindByRoleNameAndOrganizationID("owner", orgID)
@@ -402,7 +430,7 @@ func (r RoleWithDomainService) UpdateRoleToEnforcer() (bool, error) {
 	if err != nil {
 		return false, err
 	}
-	ok, err := r.enforcerRoleRepository.ClearAllGrouping()
+	ok, err := r.enforcerRoleRepository.ClearOrganizationGrouping()
 	if err != nil {
 		log.Fatal("Failed to clear all grouping : " + err.Error())
 		return false, err
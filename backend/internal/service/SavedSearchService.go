@@ -0,0 +1,370 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/infrastructure/search"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
+	"github.com/DAF-Bridge/asaiasa-Backend/logs"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
+	"github.com/google/uuid"
+	"github.com/opensearch-project/opensearch-go"
+	"gorm.io/gorm"
+)
+
+const (
+	// digestSearchSize is how many of the top results are checked for new hits on each run
+	digestSearchSize = 50
+	dueBatchSize     = 100
+)
+
+type savedSearchService struct {
+	savedSearchRepo models.SavedSearchRepository
+	OS              *opensearch.Client
+	mailRepo        repository.DigestMailRepository
+}
+
+func NewSavedSearchService(savedSearchRepo models.SavedSearchRepository, OS *opensearch.Client, mailRepo repository.DigestMailRepository) SavedSearchService {
+	return savedSearchService{
+		savedSearchRepo: savedSearchRepo,
+		OS:              OS,
+		mailRepo:        mailRepo,
+	}
+}
+
+func (s savedSearchService) CreateSavedSearch(userID uuid.UUID, req dto.SavedSearchRequest) (*dto.SavedSearchResponse, error) {
+	kind := models.SavedSearchKind(req.Kind)
+	query, err := normalizeSavedSearchQuery(kind, req.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	frequency := models.DigestFrequency(req.Frequency)
+	savedSearch := &models.SavedSearch{
+		UserID:           userID,
+		Name:             req.Name,
+		Kind:             kind,
+		Query:            query,
+		Frequency:        frequency,
+		Subscribed:       true,
+		UnsubscribeToken: utils.GenerateStateString(),
+		NextRunAt:        time.Now().Add(frequency.Interval()),
+	}
+
+	if err := s.savedSearchRepo.Create(savedSearch); err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	s.seedHits(*savedSearch)
+
+	res := dto.BuildSavedSearchResponse(*savedSearch)
+	return &res, nil
+}
+
+func (s savedSearchService) ListSavedSearches(userID uuid.UUID) ([]dto.SavedSearchResponse, error) {
+	savedSearches, err := s.savedSearchRepo.ListByUserID(userID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return dto.BuildSavedSearchResponses(savedSearches), nil
+}
+
+func (s savedSearchService) GetSavedSearch(userID uuid.UUID, id uint) (*dto.SavedSearchResponse, error) {
+	savedSearch, err := s.getOwnedSavedSearch(userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	res := dto.BuildSavedSearchResponse(*savedSearch)
+	return &res, nil
+}
+
+func (s savedSearchService) UpdateSavedSearch(userID uuid.UUID, id uint, req dto.UpdateSavedSearchRequest) (*dto.SavedSearchResponse, error) {
+	savedSearch, err := s.getOwnedSavedSearch(userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := normalizeSavedSearchQuery(savedSearch.Kind, req.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	// jsonb does not keep key order, so the stored query is normalized again before comparing
+	storedQuery, _ := normalizeSavedSearchQuery(savedSearch.Kind, json.RawMessage(savedSearch.Query))
+	queryChanged := query != storedQuery
+	frequency := models.DigestFrequency(req.Frequency)
+	if frequency != savedSearch.Frequency {
+		savedSearch.NextRunAt = time.Now().Add(frequency.Interval())
+	}
+
+	savedSearch.Name = req.Name
+	savedSearch.Query = query
+	savedSearch.Frequency = frequency
+	savedSearch.Subscribed = req.Subscribed
+
+	if err := s.savedSearchRepo.Update(savedSearch); err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if queryChanged {
+		s.seedHits(*savedSearch)
+	}
+
+	res := dto.BuildSavedSearchResponse(*savedSearch)
+	return &res, nil
+}
+
+func (s savedSearchService) DeleteSavedSearch(userID uuid.UUID, id uint) error {
+	if err := s.savedSearchRepo.Delete(id, userID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("saved search not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s savedSearchService) Unsubscribe(token string) error {
+	if err := s.savedSearchRepo.Unsubscribe(token); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("invalid unsubscribe link")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s savedSearchService) SendDueDigests(now time.Time) error {
+	users := map[uuid.UUID]models.User{}
+	sections := map[uuid.UUID][]repository.DigestMailSection{}
+	// hits are the items of each section, recorded as seen once the digest carrying them is sent
+	hits := map[uuid.UUID]map[uint][]uint{}
+
+	for {
+		due, err := s.savedSearchRepo.ListDue(now, dueBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, savedSearch := range due {
+			err := s.savedSearchRepo.Claim(savedSearch.ID, savedSearch.NextRunAt, now.Add(savedSearch.Frequency.Interval()), now)
+			if err != nil {
+				if errors.Is(err, models.ErrSavedSearchAlreadyClaimed) {
+					continue
+				}
+				return err
+			}
+
+			ids, items, err := s.newHits(savedSearch)
+			if err != nil {
+				logs.Error(fmt.Sprintf("Failed to run saved search %d: %v", savedSearch.ID, err))
+				continue
+			}
+			if len(items) == 0 {
+				continue
+			}
+
+			users[savedSearch.UserID] = savedSearch.User
+			sections[savedSearch.UserID] = append(sections[savedSearch.UserID], repository.DigestMailSection{
+				Name:             savedSearch.Name,
+				UnsubscribeToken: savedSearch.UnsubscribeToken,
+				Items:            items,
+			})
+			if hits[savedSearch.UserID] == nil {
+				hits[savedSearch.UserID] = map[uint][]uint{}
+			}
+			hits[savedSearch.UserID][savedSearch.ID] = ids
+		}
+
+		if len(due) < dueBatchSize {
+			break
+		}
+	}
+
+	for userID, userSections := range sections {
+		user := users[userID]
+		err := s.mailRepo.SendDigestMail(repository.DigestMailConfig{
+			ToEmail: user.Email,
+			Subject: "New results for your saved searches on ASAiASA",
+			Body:    repository.DigestMailBody{Name: user.Name, Sections: userSections},
+		})
+		if err != nil {
+			// Left unrecorded, the results are sent again with the next digest
+			logs.Error(fmt.Sprintf("Failed to send saved search digest to user %s: %v", userID, err))
+			continue
+		}
+
+		for savedSearchID, ids := range hits[userID] {
+			if err := s.savedSearchRepo.RecordHits(savedSearchID, ids); err != nil {
+				logs.Error(fmt.Sprintf("Failed to record the results sent for saved search %d: %v", savedSearchID, err))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s savedSearchService) getOwnedSavedSearch(userID uuid.UUID, id uint) (*models.SavedSearch, error) {
+	savedSearch, err := s.savedSearchRepo.GetByIDAndUserID(id, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("saved search not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return savedSearch, nil
+}
+
+// seedHits marks the current results as seen, so the first digest only carries what is posted
+// after the search was saved. A failed search only means the first digest may repeat older results.
+func (s savedSearchService) seedHits(savedSearch models.SavedSearch) {
+	ids, _, err := s.runSearch(savedSearch)
+	if err == nil {
+		err = s.savedSearchRepo.RecordHits(savedSearch.ID, ids)
+	}
+	if err != nil {
+		logs.Warn(fmt.Sprintf("Failed to seed results of saved search %d: %v", savedSearch.ID, err))
+	}
+}
+
+// newHits runs the saved search and returns the results not sent before, with their IDs
+func (s savedSearchService) newHits(savedSearch models.SavedSearch) ([]uint, []repository.DigestMailItem, error) {
+	ids, items, err := s.runSearch(savedSearch)
+	if err != nil || len(ids) == 0 {
+		return nil, nil, err
+	}
+
+	newIDs, err := s.savedSearchRepo.UnseenHits(savedSearch.ID, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newItems := make([]repository.DigestMailItem, 0, len(newIDs))
+	for _, id := range newIDs {
+		newItems = append(newItems, items[id])
+	}
+
+	return newIDs, newItems, nil
+}
+
+// runSearch runs the saved search, returning the IDs of its results in order and their digest items
+func (s savedSearchService) runSearch(savedSearch models.SavedSearch) ([]uint, map[uint]repository.DigestMailItem, error) {
+	var ids []uint
+	items := map[uint]repository.DigestMailItem{}
+
+	switch savedSearch.Kind {
+	case models.SavedSearchKindJob:
+		var query dto.SearchJobQuery
+		if err := json.Unmarshal([]byte(savedSearch.Query), &query); err != nil {
+			return nil, nil, err
+		}
+		query.Page, query.Offset = 1, digestSearchSize
+
+		res, err := search.SearchJobs(s.OS, query, query.Page, query.Offset)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, job := range res.Jobs {
+			ids = append(ids, job.ID)
+			items[job.ID] = repository.DigestMailItem{
+				Title:  job.Title,
+				Detail: joinNonEmpty(" · ", job.Organization.Name, job.Province),
+				Path:   fmt.Sprintf("/jobs/%d", job.ID),
+			}
+		}
+	case models.SavedSearchKindEvent:
+		var query dto.SearchQuery
+		if err := json.Unmarshal([]byte(savedSearch.Query), &query); err != nil {
+			return nil, nil, err
+		}
+		query.Page, query.Offset = 1, digestSearchSize
+
+		res, err := search.SearchEvents(s.OS, query, query.Page, query.Offset)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, event := range res.Events {
+			ids = append(ids, event.ID)
+			items[event.ID] = repository.DigestMailItem{
+				Title:  event.Name,
+				Detail: joinNonEmpty(" · ", event.Organization.Name, event.StartDate),
+				Path:   fmt.Sprintf("/events/%d", event.ID),
+			}
+		}
+	default:
+		return nil, nil, fmt.Errorf("unknown saved search kind %q", savedSearch.Kind)
+	}
+
+	return ids, items, nil
+}
+
+// normalizeSavedSearchQuery checks the query against the search it belongs to and returns it
+// re-encoded without paging, which the scheduler sets itself.
+func normalizeSavedSearchQuery(kind models.SavedSearchKind, raw json.RawMessage) (string, error) {
+	var query interface{}
+	switch kind {
+	case models.SavedSearchKindJob:
+		query = &dto.SearchJobQuery{}
+	case models.SavedSearchKindEvent:
+		query = &dto.SearchQuery{}
+	default:
+		return "", errs.NewBadRequestError("kind must be job or event")
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(query); err != nil {
+		return "", errs.NewBadRequestError("invalid search query: " + err.Error())
+	}
+
+	switch q := query.(type) {
+	case *dto.SearchJobQuery:
+		q.Page, q.Offset = 0, 0
+	case *dto.SearchQuery:
+		q.Page, q.Offset = 0, 0
+		if len(utils.ValidateStruct(q)) > 0 {
+			return "", errs.NewBadRequestError("event search query requires q")
+		}
+	}
+
+	encoded, err := json.Marshal(query)
+	if err != nil {
+		logs.Error(err)
+		return "", errs.NewUnexpectedError()
+	}
+
+	return string(encoded), nil
+}
+
+func joinNonEmpty(sep string, parts ...string) string {
+	nonEmpty := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part != "" {
+			nonEmpty = append(nonEmpty, part)
+		}
+	}
+
+	return strings.Join(nonEmpty, sep)
+}
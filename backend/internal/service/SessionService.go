@@ -0,0 +1,243 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/logs"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	AccessTokenTTL  = 15 * time.Minute
+	RefreshTokenTTL = 30 * 24 * time.Hour
+)
+
+// AuthTokens is the pair handed to a client after login or refresh
+type AuthTokens struct {
+	AccessToken      string
+	AccessExpiresAt  time.Time
+	RefreshToken     string
+	RefreshExpiresAt time.Time
+}
+
+// SessionClient describes the device a session was opened from
+type SessionClient struct {
+	UserAgent string
+	IPAddress string
+}
+
+type sessionService struct {
+	sessionRepo models.SessionRepository
+	jwtSecret   string
+}
+
+func NewSessionService(sessionRepo models.SessionRepository, jwtSecret string) SessionService {
+	return sessionService{sessionRepo: sessionRepo, jwtSecret: jwtSecret}
+}
+
+func (s sessionService) Issue(user *models.User, client SessionClient) (*AuthTokens, error) {
+	if user.SuspendedAt != nil {
+		return nil, errs.NewForbiddenError("this account has been suspended")
+	}
+
+	refreshToken, refreshHash, err := generateRefreshToken()
+	if err != nil {
+		logs.Error(fmt.Sprintf("Failed to generate refresh token: %v", err))
+		return nil, errs.NewUnexpectedError()
+	}
+
+	refreshExpiresAt := time.Now().Add(RefreshTokenTTL)
+	session := &models.UserSession{
+		ID:        uuid.New(),
+		UserID:    user.ID,
+		UserAgent: truncateString(client.UserAgent, 255),
+		IPAddress: truncateString(client.IPAddress, 64),
+		ExpiresAt: refreshExpiresAt,
+	}
+
+	if err := s.sessionRepo.Create(session, &models.RefreshToken{TokenHash: refreshHash, ExpiresAt: refreshExpiresAt}); err != nil {
+		logs.Error(fmt.Sprintf("Failed to create session: %v", err))
+		return nil, errs.NewUnexpectedError()
+	}
+
+	accessToken, accessExpiresAt, err := s.generateAccessToken(user, session.ID)
+	if err != nil {
+		logs.Error(fmt.Sprintf("Failed to generate JWT: %v", err))
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return &AuthTokens{
+		AccessToken:      accessToken,
+		AccessExpiresAt:  accessExpiresAt,
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: refreshExpiresAt,
+	}, nil
+}
+
+func (s sessionService) Refresh(refreshToken string) (*AuthTokens, error) {
+	stored, err := s.sessionRepo.GetRefreshTokenByHash(hashRefreshToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewUnauthorizedError("invalid refresh token")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if !isSessionActive(&stored.Session) {
+		return nil, errs.NewUnauthorizedError("session has expired, please log in again")
+	}
+
+	if stored.RotatedAt != nil {
+		return nil, s.revokeReusedSession(stored.SessionID)
+	}
+
+	if stored.Session.User.SuspendedAt != nil {
+		return nil, errs.NewForbiddenError("this account has been suspended")
+	}
+
+	if time.Now().After(stored.ExpiresAt) {
+		return nil, errs.NewUnauthorizedError("session has expired, please log in again")
+	}
+
+	newRefreshToken, newRefreshHash, err := generateRefreshToken()
+	if err != nil {
+		logs.Error(fmt.Sprintf("Failed to generate refresh token: %v", err))
+		return nil, errs.NewUnexpectedError()
+	}
+
+	refreshExpiresAt := time.Now().Add(RefreshTokenTTL)
+	next := &models.RefreshToken{
+		SessionID: stored.SessionID,
+		TokenHash: newRefreshHash,
+		ExpiresAt: refreshExpiresAt,
+	}
+	if err := s.sessionRepo.Rotate(stored.ID, next); err != nil {
+		if errors.Is(err, models.ErrRefreshTokenReused) {
+			return nil, s.revokeReusedSession(stored.SessionID)
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	accessToken, accessExpiresAt, err := s.generateAccessToken(&stored.Session.User, stored.SessionID)
+	if err != nil {
+		logs.Error(fmt.Sprintf("Failed to generate JWT: %v", err))
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return &AuthTokens{
+		AccessToken:      accessToken,
+		AccessExpiresAt:  accessExpiresAt,
+		RefreshToken:     newRefreshToken,
+		RefreshExpiresAt: refreshExpiresAt,
+	}, nil
+}
+
+func (s sessionService) RevokeByRefreshToken(refreshToken string) error {
+	stored, err := s.sessionRepo.GetRefreshTokenByHash(hashRefreshToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return s.RevokeSession(stored.SessionID)
+}
+
+func (s sessionService) RevokeSession(sessionID uuid.UUID) error {
+	if err := s.sessionRepo.RevokeSession(sessionID); err != nil {
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s sessionService) RevokeAll(userID uuid.UUID) error {
+	if err := s.sessionRepo.RevokeAllByUserID(userID); err != nil {
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s sessionService) IsSessionActive(sessionID uuid.UUID) (bool, error) {
+	session, err := s.sessionRepo.GetSessionByID(sessionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return isSessionActive(session) && session.User.SuspendedAt == nil, nil
+}
+
+func (s sessionService) revokeReusedSession(sessionID uuid.UUID) error {
+	logs.Warn(fmt.Sprintf("Refresh token reuse detected, revoking session %s", sessionID))
+	if err := s.sessionRepo.RevokeSession(sessionID); err != nil {
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return errs.NewUnauthorizedError("refresh token has already been used, please log in again")
+}
+
+func (s sessionService) generateAccessToken(user *models.User, sessionID uuid.UUID) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(AccessTokenTTL)
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"user_id": user.ID,
+		"email":   user.Email,
+		"sid":     sessionID.String(),
+		"iat":     now.Unix(),
+		"exp":     expiresAt.Unix(),
+	})
+
+	signed, err := token.SignedString([]byte(s.jwtSecret))
+	return signed, expiresAt, err
+}
+
+func isSessionActive(session *models.UserSession) bool {
+	return session.RevokedAt == nil && time.Now().Before(session.ExpiresAt)
+}
+
+// generateRefreshToken returns an opaque token for the client and the hash to store
+func generateRefreshToken() (string, string, error) {
+	randomBytes := make([]byte, 32)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", "", err
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(randomBytes)
+	return token, hashRefreshToken(token), nil
+}
+
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func truncateString(value string, limit int) string {
+	if len(value) > limit {
+		return value[:limit]
+	}
+	return value
+}
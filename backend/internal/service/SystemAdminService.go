@@ -0,0 +1,396 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
+	"github.com/DAF-Bridge/asaiasa-Backend/logs"
+	"github.com/DAF-Bridge/asaiasa-Backend/pkg/authorization"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+const numberOfSysAdminUsers = 20
+
+type systemAdminService struct {
+	userRepo       repository.UserRepository
+	enforcerRepo   repository.EnforcerRoleRepository
+	categoryRepo   models.CategoryRepository
+	industryRepo   models.IndustryRepository
+	statsRepo      models.PlatformStatsRepository
+	sessionService SessionService
+}
+
+func NewSystemAdminService(userRepo repository.UserRepository, enforcerRepo repository.EnforcerRoleRepository,
+	categoryRepo models.CategoryRepository, industryRepo models.IndustryRepository, statsRepo models.PlatformStatsRepository,
+	sessionService SessionService) SystemAdminService {
+	return systemAdminService{
+		userRepo:       userRepo,
+		enforcerRepo:   enforcerRepo,
+		categoryRepo:   categoryRepo,
+		industryRepo:   industryRepo,
+		statsRepo:      statsRepo,
+		sessionService: sessionService,
+	}
+}
+
+func (s systemAdminService) SearchUsers(query string, page int) (*dto.PaginatedSysAdminUsersResponse, error) {
+	users, total, err := s.userRepo.Search(strings.TrimSpace(query), page, numberOfSysAdminUsers)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	admins, err := s.systemAdminIDs()
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.SysAdminUserResponse, 0, len(users))
+	for _, user := range users {
+		responses = append(responses, convertToSysAdminUserResponse(user, admins[user.ID.String()]))
+	}
+
+	return &dto.PaginatedSysAdminUsersResponse{Users: responses, TotalUsers: total}, nil
+}
+
+func (s systemAdminService) SuspendUser(adminID uuid.UUID, userID uuid.UUID, reason string) (*dto.SysAdminUserResponse, error) {
+	if adminID == userID {
+		return nil, errs.NewBadRequestError("you cannot suspend your own account")
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, errs.NewBadRequestError("a reason is required to suspend a user")
+	}
+
+	now := time.Now()
+	if err := s.userRepo.UpdateSuspension(userID, &now, reason); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("user not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	// The auth middleware checks the session on every request, so this logs the user out right away
+	if err := s.sessionService.RevokeAll(userID); err != nil {
+		return nil, err
+	}
+
+	return s.getUser(userID)
+}
+
+func (s systemAdminService) ReinstateUser(userID uuid.UUID) (*dto.SysAdminUserResponse, error) {
+	if err := s.userRepo.UpdateSuspension(userID, nil, ""); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("user not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return s.getUser(userID)
+}
+
+func (s systemAdminService) ForceLogout(userID uuid.UUID) error {
+	if _, err := s.getUser(userID); err != nil {
+		return err
+	}
+
+	return s.sessionService.RevokeAll(userID)
+}
+
+func (s systemAdminService) GrantSystemAdmin(userID uuid.UUID) (*dto.SysAdminUserResponse, error) {
+	if _, err := s.getUser(userID); err != nil {
+		return nil, err
+	}
+
+	// Granting twice is not an error; the user simply stays a system admin
+	if _, err := s.enforcerRepo.AddRoleForUserInDomain(userID.String(), authorization.SystemAdmin, authorization.GlobalDomain); err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return s.getUser(userID)
+}
+
+func (s systemAdminService) RevokeSystemAdmin(adminID uuid.UUID, userID uuid.UUID) (*dto.SysAdminUserResponse, error) {
+	if adminID == userID {
+		return nil, errs.NewBadRequestError("you cannot revoke your own system admin role")
+	}
+
+	ok, err := s.enforcerRepo.DeleteRoleForUserInDomain(userID.String(), authorization.SystemAdmin, authorization.GlobalDomain)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+	if !ok {
+		return nil, errs.NewNotFoundError("user is not a system admin")
+	}
+
+	return s.getUser(userID)
+}
+
+func (s systemAdminService) ListCategories() ([]dto.SysAdminCategoryResponse, error) {
+	categories, err := s.categoryRepo.List()
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	responses := make([]dto.SysAdminCategoryResponse, 0, len(categories))
+	for _, category := range categories {
+		responses = append(responses, convertToSysAdminCategoryResponse(category))
+	}
+
+	return responses, nil
+}
+
+func (s systemAdminService) CreateCategory(req dto.SysAdminCategoryRequest) (*dto.SysAdminCategoryResponse, error) {
+	if err := s.checkParentCategory(0, req.ParentID); err != nil {
+		return nil, err
+	}
+
+	category := &models.Category{IsActive: true}
+	applyCategoryRequest(category, req)
+
+	if err := s.categoryRepo.Create(category); err != nil {
+		return nil, categoryWriteError(err)
+	}
+
+	response := convertToSysAdminCategoryResponse(*category)
+	return &response, nil
+}
+
+func (s systemAdminService) UpdateCategory(categoryID uint, req dto.SysAdminCategoryRequest) (*dto.SysAdminCategoryResponse, error) {
+	category, err := s.categoryRepo.GetByID(categoryID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("category not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	if err := s.checkParentCategory(categoryID, req.ParentID); err != nil {
+		return nil, err
+	}
+
+	applyCategoryRequest(category, req)
+	if err := s.categoryRepo.Update(category); err != nil {
+		return nil, categoryWriteError(err)
+	}
+
+	response := convertToSysAdminCategoryResponse(*category)
+	return &response, nil
+}
+
+func (s systemAdminService) DeleteCategory(categoryID uint) error {
+	if err := s.categoryRepo.Delete(categoryID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("category not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s systemAdminService) ListIndustries() ([]dto.IndustryResponses, error) {
+	industries, err := s.industryRepo.List()
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	responses := make([]dto.IndustryResponses, 0, len(industries))
+	for _, industry := range industries {
+		responses = append(responses, dto.BuildIndustryResponses(industry))
+	}
+
+	return responses, nil
+}
+
+func (s systemAdminService) CreateIndustry(req dto.IndustryRequest) (*dto.IndustryResponses, error) {
+	industry := &models.Industry{Industry: strings.TrimSpace(req.Name)}
+	if err := s.industryRepo.Create(industry); err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	response := dto.BuildIndustryResponses(*industry)
+	return &response, nil
+}
+
+func (s systemAdminService) UpdateIndustry(industryID uint, req dto.IndustryRequest) (*dto.IndustryResponses, error) {
+	industry, err := s.industryRepo.GetByID(industryID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("industry not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	industry.Industry = strings.TrimSpace(req.Name)
+	if err := s.industryRepo.Update(industry); err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	response := dto.BuildIndustryResponses(*industry)
+	return &response, nil
+}
+
+func (s systemAdminService) DeleteIndustry(industryID uint) error {
+	if err := s.industryRepo.Delete(industryID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("industry not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s systemAdminService) GetPlatformStats() (*dto.PlatformStatsResponse, error) {
+	stats, err := s.statsRepo.GetPlatformStats()
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return &dto.PlatformStatsResponse{
+		Users:                 stats.Users,
+		SuspendedUsers:        stats.SuspendedUsers,
+		NewUsersLast30Days:    stats.NewUsersLast30Days,
+		OrganizationsByStatus: stats.OrganizationsByStatus,
+		EventsByStatus:        stats.EventsByStatus,
+		JobsByStatus:          stats.JobsByStatus,
+		Applications:          stats.Applications,
+		TicketsPurchased:      stats.TicketsPurchased,
+	}, nil
+}
+
+func (s systemAdminService) getUser(userID uuid.UUID) (*dto.SysAdminUserResponse, error) {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("user not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	admins, err := s.systemAdminIDs()
+	if err != nil {
+		return nil, err
+	}
+
+	response := convertToSysAdminUserResponse(*user, admins[user.ID.String()])
+	return &response, nil
+}
+
+func (s systemAdminService) systemAdminIDs() (map[string]bool, error) {
+	userIDs, err := s.enforcerRepo.GetUsersByRoleInDomain(authorization.SystemAdmin, authorization.GlobalDomain)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	admins := make(map[string]bool, len(userIDs))
+	for _, id := range userIDs {
+		admins[id] = true
+	}
+
+	return admins, nil
+}
+
+// checkParentCategory makes sure parentID names another existing category
+func (s systemAdminService) checkParentCategory(categoryID uint, parentID *uint) error {
+	if parentID == nil {
+		return nil
+	}
+	if *parentID == categoryID {
+		return errs.NewBadRequestError("a category cannot be its own parent")
+	}
+
+	if _, err := s.categoryRepo.GetByID(*parentID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewBadRequestError("parent category not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func applyCategoryRequest(category *models.Category, req dto.SysAdminCategoryRequest) {
+	category.Name = strings.TrimSpace(req.Name)
+	category.Slug = strings.TrimSpace(req.Slug)
+	category.ParentID = req.ParentID
+	category.SortOrder = req.SortOrder
+	if req.IsActive != nil {
+		category.IsActive = *req.IsActive
+	}
+}
+
+func categoryWriteError(err error) error {
+	var pqErr *pgconn.PgError
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return errs.NewConflictError("a category with this slug already exists")
+	}
+
+	logs.Error(err)
+	return errs.NewUnexpectedError()
+}
+
+func convertToSysAdminUserResponse(user models.User, isSystemAdmin bool) dto.SysAdminUserResponse {
+	var suspendedAt *string
+	if user.SuspendedAt != nil {
+		formatted := user.SuspendedAt.Format(time.RFC3339)
+		suspendedAt = &formatted
+	}
+
+	return dto.SysAdminUserResponse{
+		ID:              user.ID.String(),
+		Name:            user.Name,
+		Email:           user.Email,
+		PicUrl:          user.PicUrl,
+		Role:            string(user.Role),
+		IsSystemAdmin:   isSystemAdmin,
+		EmailVerified:   user.EmailVerifiedAt != nil,
+		SuspendedAt:     suspendedAt,
+		SuspendedReason: user.SuspendedReason,
+		CreatedAt:       user.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func convertToSysAdminCategoryResponse(category models.Category) dto.SysAdminCategoryResponse {
+	return dto.SysAdminCategoryResponse{
+		ID:        category.ID,
+		Name:      category.Name,
+		Slug:      category.Slug,
+		ParentID:  category.ParentID,
+		IsActive:  category.IsActive,
+		SortOrder: category.SortOrder,
+	}
+}
@@ -0,0 +1,406 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
+	"github.com/DAF-Bridge/asaiasa-Backend/logs"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+type ticketAvailableService struct {
+	ticketRepo models.TicketAvailableRepository
+	eventRepo  repository.EventRepository
+}
+
+func NewTicketAvailableService(ticketRepo models.TicketAvailableRepository, eventRepo repository.EventRepository) models.TicketAvailableService {
+	return ticketAvailableService{
+		ticketRepo: ticketRepo,
+		eventRepo:  eventRepo,
+	}
+}
+
+func (s ticketAvailableService) GetByID(id uint) (*models.TicketAvailable, error) {
+	ticket, err := s.ticketRepo.GetByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("ticket not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return ticket, nil
+}
+
+func (s ticketAvailableService) GetAll() ([]models.TicketAvailable, error) {
+	tickets, err := s.ticketRepo.GetAll()
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return tickets, nil
+}
+
+func (s ticketAvailableService) GetAllByEventID(eventID uint) ([]models.TicketAvailable, error) {
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+	if !event.VisibleToPublic() {
+		return nil, errs.NewNotFoundError("event not found")
+	}
+
+	tickets, err := s.ticketRepo.GetAllByEventID(eventID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return tickets, nil
+}
+
+func (s ticketAvailableService) GetAllByEventIDwithOrgID(orgID uint, eventID uint) ([]models.TicketAvailable, error) {
+	if _, err := s.eventRepo.GetByIDwithOrgID(orgID, eventID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	tickets, err := s.ticketRepo.GetAllByEventID(eventID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return tickets, nil
+}
+
+func (s ticketAvailableService) Create(orgID uint, eventID uint, ticketAvailable *models.TicketAvailable) error {
+	if err := s.checkPaidEventInOrg(orgID, eventID); err != nil {
+		return err
+	}
+
+	ticketAvailable.EventID = eventID
+	if err := s.ticketRepo.Create(ticketAvailable); err != nil {
+		if isCheckViolation(err) {
+			return errs.NewBadRequestError("quantity and price must not be negative")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s ticketAvailableService) Update(orgID uint, eventID uint, ticketAvailable *models.TicketAvailable) error {
+	if err := s.checkPaidEventInOrg(orgID, eventID); err != nil {
+		return err
+	}
+
+	ticketAvailable.EventID = eventID
+	if err := s.ticketRepo.Update(ticketAvailable); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("ticket not found")
+		}
+		if isCheckViolation(err) {
+			return errs.NewBadRequestError("quantity and price must not be negative")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s ticketAvailableService) Delete(orgID uint, eventID uint, id uint) error {
+	if _, err := s.eventRepo.GetByIDwithOrgID(orgID, eventID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	ticket, err := s.ticketRepo.GetByID(id)
+	if err != nil || ticket.EventID != eventID {
+		if err == nil || errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("ticket not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	if err := s.ticketRepo.Delete(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("ticket not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+// isCheckViolation reports whether err is a violated check constraint, such as a negative price
+func isCheckViolation(err error) bool {
+	var pqErr *pgconn.PgError
+	return errors.As(err, &pqErr) && pqErr.Code == "23514"
+}
+
+func (s ticketAvailableService) checkPaidEventInOrg(orgID uint, eventID uint) error {
+	event, err := s.eventRepo.GetByIDwithOrgID(orgID, eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	if event.PriceType == string(models.Free) {
+		return errs.NewBadRequestError("tickets can only be sold for paid events")
+	}
+
+	return nil
+}
+
+type ticketPurchasedService struct {
+	purchaseRepo models.TicketPurchasedRepository
+	ticketRepo   models.TicketAvailableRepository
+	eventRepo    repository.EventRepository
+	userRepo     repository.UserRepository
+	roleRepo     models.RoleRepository
+	qrSecret     string
+	live         LiveEventPublisher
+}
+
+func NewTicketPurchasedService(purchaseRepo models.TicketPurchasedRepository, ticketRepo models.TicketAvailableRepository,
+	eventRepo repository.EventRepository, userRepo repository.UserRepository, roleRepo models.RoleRepository, qrSecret string,
+	live LiveEventPublisher) models.TicketPurchasedService {
+	return ticketPurchasedService{
+		purchaseRepo: purchaseRepo,
+		ticketRepo:   ticketRepo,
+		eventRepo:    eventRepo,
+		userRepo:     userRepo,
+		roleRepo:     roleRepo,
+		qrSecret:     qrSecret,
+		live:         live,
+	}
+}
+
+func (s ticketPurchasedService) Purchase(userID uuid.UUID, eventID uint, ticketAvailableID uint, phone string) (*models.TicketPurchased, error) {
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+	if !event.VisibleToPublic() {
+		return nil, errs.NewNotFoundError("event not found")
+	}
+
+	if event.PriceType == string(models.Free) {
+		return nil, errs.NewBadRequestError("this event is free, no ticket is required")
+	}
+
+	ticket, err := s.ticketRepo.GetByID(ticketAvailableID)
+	if err != nil || ticket.EventID != eventID {
+		if err == nil || errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("ticket not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("user not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	purchase := &models.TicketPurchased{
+		UserID:            userID,
+		EventID:           eventID,
+		TicketAvailableID: ticket.ID,
+		Username:          user.Name,
+		Email:             user.Email,
+		Phone:             phone,
+		TicketTitle:       ticket.Title,
+		Qrcode:            utils.SignTicketQR(s.qrSecret, eventID),
+	}
+
+	if err := s.purchaseRepo.Purchase(purchase); err != nil {
+		if errors.Is(err, models.ErrTicketSoldOut) {
+			return nil, errs.NewConflictError("ticket is sold out")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	purchase.Event = *event
+	return purchase, nil
+}
+
+func (s ticketPurchasedService) GetByIDAndUserID(userID uuid.UUID, id uint) (*models.TicketPurchased, error) {
+	purchase, err := s.purchaseRepo.GetByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("ticket not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	// Do not reveal other users' tickets
+	if purchase.UserID != userID {
+		return nil, errs.NewNotFoundError("ticket not found")
+	}
+
+	return purchase, nil
+}
+
+func (s ticketPurchasedService) GetAllByUserID(userID uuid.UUID) ([]models.TicketPurchased, error) {
+	purchases, err := s.purchaseRepo.GetAllByUserID(userID)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return purchases, nil
+}
+
+func (s ticketPurchasedService) CheckIn(orgID uint, eventID uint, qrcode string) (*models.TicketPurchased, error) {
+	if _, err := s.eventRepo.GetByIDwithOrgID(orgID, eventID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	qrEventID, err := utils.VerifyTicketQR(s.qrSecret, qrcode)
+	if err != nil {
+		return nil, errs.NewBadRequestError("invalid qr code")
+	}
+	if qrEventID != eventID {
+		return nil, errs.NewBadRequestError("ticket belongs to another event")
+	}
+
+	confirmationAt := time.Now().Format(time.RFC3339)
+	if err := s.purchaseRepo.CheckIn(eventID, qrcode, confirmationAt); err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			logs.Error(err)
+			return nil, errs.NewUnexpectedError()
+		}
+
+		// Nothing was updated: either the ticket does not exist or it was already scanned
+		purchase, err := s.purchaseRepo.GetByQrcode(qrcode)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errs.NewNotFoundError("ticket not found")
+			}
+
+			logs.Error(err)
+			return nil, errs.NewUnexpectedError()
+		}
+
+		return nil, errs.NewConflictError("ticket already used at " + purchase.ConfirmationAt)
+	}
+
+	purchase, err := s.purchaseRepo.GetByQrcode(qrcode)
+	if err != nil {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	s.streamCheckIn(orgID, *purchase)
+
+	return purchase, nil
+}
+
+// streamCheckIn tells the ticket holder their ticket was scanned, and updates the live attendance
+// of the organization members watching the event
+func (s ticketPurchasedService) streamCheckIn(orgID uint, purchase models.TicketPurchased) {
+	if s.live == nil {
+		return
+	}
+
+	ticket := dto.BuildTicketPurchasedResponse(purchase)
+	s.live.SendToUsers([]uuid.UUID{purchase.UserID}, StreamEventTicketCheckIn, ticket)
+
+	members, err := s.roleRepo.FindByOrganizationID(orgID)
+	if err != nil {
+		logs.Warn("Failed to look up members for the check-in stream: " + err.Error())
+		return
+	}
+	total, checkedIn, err := s.purchaseRepo.CountByEventID(purchase.EventID)
+	if err != nil {
+		logs.Warn("Failed to count attendance for the check-in stream: " + err.Error())
+		return
+	}
+
+	memberIDs := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		if member.UserID != purchase.UserID {
+			memberIDs = append(memberIDs, member.UserID)
+		}
+	}
+	s.live.SendToUsers(memberIDs, StreamEventAttendance, dto.CheckInResponse{
+		Ticket:     ticket,
+		Attendance: dto.AttendanceResponse{EventID: purchase.EventID, Total: total, CheckedIn: checkedIn},
+	})
+}
+
+func (s ticketPurchasedService) GetAttendance(orgID uint, eventID uint) (int64, int64, error) {
+	if _, err := s.eventRepo.GetByIDwithOrgID(orgID, eventID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, 0, errs.NewNotFoundError("event not found")
+		}
+
+		logs.Error(err)
+		return 0, 0, errs.NewUnexpectedError()
+	}
+
+	total, checkedIn, err := s.purchaseRepo.CountByEventID(eventID)
+	if err != nil {
+		logs.Error(err)
+		return 0, 0, errs.NewUnexpectedError()
+	}
+
+	return total, checkedIn, nil
+}
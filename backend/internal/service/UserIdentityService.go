@@ -0,0 +1,130 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
+	"github.com/DAF-Bridge/asaiasa-Backend/logs"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+type userIdentityService struct {
+	identityRepo models.UserIdentityRepository
+	userRepo     repository.UserRepository
+}
+
+func NewUserIdentityService(identityRepo models.UserIdentityRepository, userRepo repository.UserRepository) models.UserIdentityService {
+	return userIdentityService{
+		identityRepo: identityRepo,
+		userRepo:     userRepo,
+	}
+}
+
+func (s userIdentityService) ListLoginMethods(userID uuid.UUID) (bool, []models.UserIdentity, error) {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil, errs.NewNotFoundError("user not found")
+		}
+
+		logs.Error(err)
+		return false, nil, errs.NewUnexpectedError()
+	}
+
+	identities, err := s.identityRepo.GetAllByUserID(userID)
+	if err != nil {
+		logs.Error(err)
+		return false, nil, errs.NewUnexpectedError()
+	}
+
+	return user.Password != nil, identities, nil
+}
+
+func (s userIdentityService) LinkPassword(userID uuid.UUID, password string) error {
+	if len(password) < minPasswordLength {
+		return errs.NewBadRequestError(fmt.Sprintf("password must be at least %d characters", minPasswordLength))
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		logs.Error("Failed to hash password")
+		return errs.NewUnexpectedError()
+	}
+
+	if err := s.identityRepo.SetPassword(userID, string(hashedPassword)); err != nil {
+		if errors.Is(err, models.ErrPasswordAlreadySet) {
+			return errs.NewConflictError("a password is already set, use reset password to change it")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s userIdentityService) UnlinkPassword(userID uuid.UUID) error {
+	if err := s.identityRepo.RemovePassword(userID); err != nil {
+		return mapUnlinkError(err, "no password is set")
+	}
+
+	return nil
+}
+
+func (s userIdentityService) LinkProvider(userID uuid.UUID, provider models.Provider, providerID string, email string) (*models.UserIdentity, error) {
+	existing, err := s.identityRepo.GetByProvider(provider, providerID)
+	if err == nil {
+		if existing.UserID == userID {
+			return existing, nil
+		}
+		return nil, errs.NewConflictError(fmt.Sprintf("this %s account is already linked to another user", provider))
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	identity := &models.UserIdentity{
+		UserID:     userID,
+		Provider:   provider,
+		ProviderID: providerID,
+		Email:      email,
+	}
+	if err := s.identityRepo.Create(identity); err != nil {
+		var pqErr *pgconn.PgError
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return nil, errs.NewConflictError(fmt.Sprintf("another %s account is already linked, unlink it first", provider))
+		}
+
+		logs.Error(err)
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return identity, nil
+}
+
+func (s userIdentityService) UnlinkProvider(userID uuid.UUID, provider models.Provider) error {
+	if err := s.identityRepo.Delete(userID, provider); err != nil {
+		return mapUnlinkError(err, fmt.Sprintf("no %s account is linked", provider))
+	}
+
+	return nil
+}
+
+func mapUnlinkError(err error, notFoundMessage string) error {
+	if errors.Is(err, models.ErrLastLoginMethod) {
+		return errs.NewBadRequestError("cannot remove the last login method, link another one first")
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errs.NewNotFoundError(notFoundMessage)
+	}
+
+	logs.Error(err)
+	return errs.NewUnexpectedError()
+}
@@ -135,6 +135,98 @@ func (s userService) FindByUserID(userId uuid.UUID) (*models.User, error) {
 	return s.userRepo.FindByID(userId)
 }
 
+type publicProfileService struct {
+	userRepo        repository.UserRepository
+	visibilityRepo  models.ProfileVisibilityRepository
+	participantRepo models.EventParticipantRepository
+}
+
+func NewPublicProfileService(userRepo repository.UserRepository, visibilityRepo models.ProfileVisibilityRepository, participantRepo models.EventParticipantRepository) PublicProfileService {
+	return publicProfileService{
+		userRepo:        userRepo,
+		visibilityRepo:  visibilityRepo,
+		participantRepo: participantRepo,
+	}
+}
+
+func (s publicProfileService) GetPublicProfile(userID uuid.UUID) (*dto.PublicProfileResponse, error) {
+	profile, err := s.userRepo.GetProfileByUserID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errs.NewNotFoundError("Profile not found")
+		}
+
+		logs.Error(fmt.Sprintf("Failed to get profile: %v", err))
+		return nil, errs.NewUnexpectedError()
+	}
+
+	// The profile outlives a soft deleted user, whose preload comes back empty
+	if profile.User.ID == uuid.Nil {
+		return nil, errs.NewNotFoundError("Profile not found")
+	}
+
+	visibility, err := s.getVisibility(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var participations []models.EventParticipant
+	if visibility.ShowEvents {
+		participations, err = s.participantRepo.GetVisibleByUserID(userID)
+		if err != nil {
+			logs.Error(fmt.Sprintf("Failed to get event participations: %v", err))
+			return nil, errs.NewUnexpectedError()
+		}
+	}
+
+	response := dto.BuildPublicProfileResponse(*profile, *visibility, participations)
+	return &response, nil
+}
+
+func (s publicProfileService) GetProfileVisibility(userID uuid.UUID) (*dto.ProfileVisibilityResponse, error) {
+	visibility, err := s.getVisibility(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := dto.BuildProfileVisibilityResponse(*visibility)
+	return &response, nil
+}
+
+func (s publicProfileService) UpdateProfileVisibility(userID uuid.UUID, req dto.ProfileVisibilityRequest) (*dto.ProfileVisibilityResponse, error) {
+	visibility := &models.ProfileVisibility{
+		UserID:          userID,
+		ShowHeadline:    req.ShowHeadline,
+		ShowBio:         req.ShowBio,
+		ShowSkills:      req.ShowSkills,
+		ShowExperiences: req.ShowExperiences,
+		ShowEvents:      req.ShowEvents,
+	}
+
+	if err := s.visibilityRepo.Upsert(visibility); err != nil {
+		logs.Error(fmt.Sprintf("Failed to update profile visibility: %v", err))
+		return nil, errs.NewUnexpectedError()
+	}
+
+	response := dto.BuildProfileVisibilityResponse(*visibility)
+	return &response, nil
+}
+
+func (s publicProfileService) getVisibility(userID uuid.UUID) (*models.ProfileVisibility, error) {
+	visibility, err := s.visibilityRepo.GetByUserID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			defaults := models.DefaultProfileVisibility(userID)
+			return &defaults, nil
+		}
+
+		logs.Error(fmt.Sprintf("Failed to get profile visibility: %v", err))
+		return nil, errs.NewUnexpectedError()
+	}
+
+	return visibility, nil
+}
+
 type userPreferenceService struct {
 	userPreferenceRepo repository.UserPreferenceRepository
 	userRepo           repository.UserRepository
@@ -0,0 +1,11 @@
+package service
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+)
+
+type AuditLogService interface {
+	// ListAuditLogs returns a page of the audit log of an organization, newest first
+	ListAuditLogs(orgID uint, filter models.AuditLogFilter, page int) (*dto.PaginatedAuditLogResponse, error)
+}
@@ -1,6 +1,12 @@
 package service
 
 import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,21 +16,42 @@ import (
 	"github.com/DAF-Bridge/asaiasa-Backend/errs"
 	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
 	"github.com/DAF-Bridge/asaiasa-Backend/utils"
-	"github.com/golang-jwt/jwt/v5"
 	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+const (
+	verifyEmailTokenTTL   = 24 * time.Hour
+	resetPasswordTokenTTL = time.Hour
+	minPasswordLength     = 8
 )
 
 type AuthService struct {
-	userRepo    repository.UserRepository
-	profileRepo *repository.ProfileRepository
-	jwtSecret   string
+	userRepo                 repository.UserRepository
+	profileRepo              *repository.ProfileRepository
+	userTokenRepo            models.UserTokenRepository
+	sessionService           SessionService
+	authMailRepository       repository.AuthMailRepository
+	tokenSecret              string
+	requireEmailVerification bool
 }
 
-func NewAuthService(userRepo repository.UserRepository, profileRepo *repository.ProfileRepository, jwtSecret string) *AuthService {
-	return &AuthService{userRepo: userRepo, profileRepo: profileRepo, jwtSecret: jwtSecret}
+func NewAuthService(userRepo repository.UserRepository, profileRepo *repository.ProfileRepository, userTokenRepo models.UserTokenRepository,
+	sessionService SessionService, authMailRepository repository.AuthMailRepository, tokenSecret string, requireEmailVerification bool) *AuthService {
+	return &AuthService{
+		userRepo:                 userRepo,
+		profileRepo:              profileRepo,
+		userTokenRepo:            userTokenRepo,
+		sessionService:           sessionService,
+		authMailRepository:       authMailRepository,
+		tokenSecret:              tokenSecret,
+		requireEmailVerification: requireEmailVerification,
+	}
 }
 
-func (s *AuthService) SignUp(name, email, password, phone string) (string, error) {
+// SignUp creates a local account and mails a verification link. When verification is
+// required no tokens are returned until the link has been followed.
+func (s *AuthService) SignUp(name, email, password, phone string, client SessionClient) (*AuthTokens, error) {
 
 	// Begin Transaction
 	tx := s.userRepo.BeginTransaction()
@@ -39,14 +66,14 @@ func (s *AuthService) SignUp(name, email, password, phone string) (string, error
 	// check if email is already taken
 	if _, err := s.userRepo.FindByEmail(email); err == nil {
 		logs.Error("Email already registered")
-		return "", errs.NewConflictError("email already registered")
+		return nil, errs.NewConflictError("email already registered, log in and add a password from your profile instead")
 	}
 
 	// Hash Password
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
 		logs.Error("Failed to hash password")
-		return "", errs.NewUnexpectedError()
+		return nil, errs.NewUnexpectedError()
 	}
 
 	hashedPasswordString := string(hashedPassword) // Convert []byte to string
@@ -55,14 +82,13 @@ func (s *AuthService) SignUp(name, email, password, phone string) (string, error
 		Name:     name,
 		Email:    email,
 		Password: &hashedPasswordString,
-		Provider: models.ProviderLocal,
 	}
 
 	// Create User
 	if err := s.userRepo.Create(user); err != nil {
 		tx.Rollback()
 		logs.Error("Failed to create user")
-		return "", errs.NewConflictError(err.Error())
+		return nil, errs.NewConflictError(err.Error())
 	}
 
 	fname, lname := utils.SeparateName(name)
@@ -80,38 +106,41 @@ func (s *AuthService) SignUp(name, email, password, phone string) (string, error
 	if err := s.profileRepo.Create(profile); err != nil {
 		tx.Rollback() // Rollback if profile creation fails
 		logs.Error("Failed to create profile")
-		return "", errs.NewUnexpectedError()
+		return nil, errs.NewUnexpectedError()
 	}
 
 	// Commit the transaction if everything is successful
 	if err := tx.Commit().Error; err != nil {
 		tx.Rollback() // Rollback if commit fails
 		logs.Error("Failed to commit create user transaction")
-		return "", errs.NewUnexpectedError()
+		return nil, errs.NewUnexpectedError()
 	}
 
-	// Generate JWT
-	userJWT, err := s.generateJWT(user)
-	if err != nil {
-		logs.Error(fmt.Sprintf("Failed to generate JWT: %v", err))
-		return "", errs.NewUnexpectedError()
+	// The account exists at this point, so a mail failure must not fail the sign up;
+	// the user can ask for a new link later.
+	if err := s.sendVerificationMail(user); err != nil {
+		logs.Error(fmt.Sprintf("Failed to send verification email: %v", err))
 	}
 
-	return userJWT, nil
+	if s.requireEmailVerification {
+		return nil, nil
+	}
+
+	return s.sessionService.Issue(user, client)
 }
 
-func (s *AuthService) LogIn(email, password string) (string, error) {
+func (s *AuthService) LogIn(email, password string, client SessionClient) (*AuthTokens, error) {
 	// Find User
 	user, err := s.userRepo.FindByEmail(email)
 	if err != nil {
 		logs.Error(fmt.Sprintf("Failed to find user: %v", err))
-		return "", errs.NewUnauthorizedError("invalid email or password")
+		return nil, errs.NewUnauthorizedError("invalid email or password")
 	}
 
-	// Check if user is not login with local Username and Password
-	if user.Provider != models.ProviderLocal || user.Password == nil {
-		logs.Error("User is not registered with local username and password")
-		return "", errs.NewForbiddenError("User is not registered with Username and Password. Please log in using the other method.")
+	// Check if user has not linked a password to the account
+	if user.Password == nil {
+		logs.Error("User has no password login")
+		return nil, errs.NewForbiddenError("User is not registered with Username and Password. Please log in using the other method.")
 	}
 
 	passwordStr := *user.Password // Convert *string to string
@@ -119,27 +148,155 @@ func (s *AuthService) LogIn(email, password string) (string, error) {
 	// Check Password
 	if err := bcrypt.CompareHashAndPassword([]byte(passwordStr), []byte(password)); err != nil {
 		logs.Error("Invalid email or password")
-		return "", errs.NewUnauthorizedError("invalid email or password")
+		return nil, errs.NewUnauthorizedError("invalid email or password")
+	}
+
+	if s.requireEmailVerification && user.EmailVerifiedAt == nil {
+		return nil, errs.NewForbiddenError("please verify your email before logging in")
 	}
 
 	// fmt.Println(user.ID)
-	// Generate JWT
-	userJWT, err := s.generateJWT(user)
+	return s.sessionService.Issue(user, client)
+}
+
+// ResendVerificationEmail mails a fresh verification link. It reports success for
+// unknown or already verified addresses so it cannot be used to probe for accounts.
+func (s *AuthService) ResendVerificationEmail(email string) error {
+	user, err := s.userRepo.FindByEmail(email)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	if user.EmailVerifiedAt != nil {
+		return nil
+	}
+
+	if err := s.sendVerificationMail(user); err != nil {
+		logs.Error(fmt.Sprintf("Failed to send verification email: %v", err))
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+func (s *AuthService) VerifyEmail(token string) error {
+	if _, err := s.userTokenRepo.VerifyEmail(s.hashUserToken(models.UserTokenVerifyEmail, token)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewBadRequestError("verification link is invalid or has expired")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+// ForgotPassword mails a reset link to accounts with password login. Like ResendVerificationEmail it
+// never reveals whether the address is registered.
+func (s *AuthService) ForgotPassword(email string) error {
+	user, err := s.userRepo.FindByEmail(email)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	// Users without a password add one from their profile instead
+	if user.Password == nil {
+		logs.Info(fmt.Sprintf("Skip password reset for user %s without password login", user.ID))
+		return nil
+	}
+
+	token, err := s.createUserToken(user, models.UserTokenResetPassword, resetPasswordTokenTTL)
+	if err != nil {
+		logs.Error(err)
+		return errs.NewUnexpectedError()
+	}
+
+	err = s.authMailRepository.SendPasswordResetMail(repository.AuthMailConfig{
+		ToEmail: user.Email,
+		Subject: "Reset your ASAiASA password",
+		Body:    repository.AuthMailBody{Name: user.Name, Token: token},
+	})
+	if err != nil {
+		logs.Error(fmt.Sprintf("Failed to send password reset email: %v", err))
+		return errs.NewUnexpectedError()
+	}
+
+	return nil
+}
+
+// ResetPassword sets a new password and logs the user out of every device
+func (s *AuthService) ResetPassword(token, password string) error {
+	if len(password) < minPasswordLength {
+		return errs.NewBadRequestError(fmt.Sprintf("password must be at least %d characters", minPasswordLength))
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		logs.Error("Failed to hash password")
+		return errs.NewUnexpectedError()
+	}
+
+	userToken, err := s.userTokenRepo.ResetPassword(s.hashUserToken(models.UserTokenResetPassword, token), string(hashedPassword))
 	if err != nil {
-		logs.Error(fmt.Sprintf("Failed to generate JWT: %v", err))
-		return "", errs.NewUnexpectedError()
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errs.NewBadRequestError("reset link is invalid or has expired")
+		}
+
+		logs.Error(err)
+		return errs.NewUnexpectedError()
 	}
 
-	return userJWT, nil
+	return s.sessionService.RevokeAll(userToken.UserID)
 }
 
-// Private Methods for local use
-func (s *AuthService) generateJWT(user *models.User) (string, error) {
-	// Generate JWT
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"user_id": user.ID,
-		"email":   user.Email,
-		"exp":     time.Now().Add(time.Hour * 24 * 7).Unix(), // 7 days
+func (s *AuthService) sendVerificationMail(user *models.User) error {
+	token, err := s.createUserToken(user, models.UserTokenVerifyEmail, verifyEmailTokenTTL)
+	if err != nil {
+		return err
+	}
+
+	return s.authMailRepository.SendVerificationMail(repository.AuthMailConfig{
+		ToEmail: user.Email,
+		Subject: "Verify your ASAiASA email address",
+		Body:    repository.AuthMailBody{Name: user.Name, Token: token},
 	})
-	return token.SignedString([]byte(s.jwtSecret))
+}
+
+// createUserToken stores a new token for the user and returns the raw value to mail
+func (s *AuthService) createUserToken(user *models.User, purpose models.UserTokenPurpose, ttl time.Duration) (string, error) {
+	randomBytes := make([]byte, 32)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(randomBytes)
+
+	err := s.userTokenRepo.Create(&models.UserToken{
+		UserID:    user.ID,
+		Purpose:   purpose,
+		TokenHash: s.hashUserToken(purpose, token),
+		ExpiresAt: time.Now().Add(ttl),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// hashUserToken binds the token to its purpose, so a verification link cannot be replayed as a reset link
+func (s *AuthService) hashUserToken(purpose models.UserTokenPurpose, token string) string {
+	mac := hmac.New(sha256.New, []byte(s.tokenSecret))
+	mac.Write([]byte(string(purpose) + ":" + token))
+	return hex.EncodeToString(mac.Sum(nil))
 }
@@ -0,0 +1,15 @@
+package service
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type BookmarkService interface {
+	AddBookmark(userID uuid.UUID, req dto.BookmarkRequest) (*dto.BookmarkResponse, error)
+	RemoveBookmark(userID uuid.UUID, targetType models.BookmarkTargetType, targetID uint) error
+	ListBookmarks(userID uuid.UUID, targetType models.BookmarkTargetType) ([]dto.BookmarkResponse, error)
+	// BookmarkedIDs reports which of targetIDs the user has bookmarked
+	BookmarkedIDs(userID uuid.UUID, targetType models.BookmarkTargetType, targetIDs []uint) (map[uint]bool, error)
+}
@@ -17,6 +17,10 @@ type EventService interface {
 	GetAllEventsByOrgID(orgID uint) ([]dto.EventResponses, error)
 	GetEventByID(eventID uint) (*dto.EventResponses, error)
 	GetEventByIDwithOrgID(orgID uint, eventID uint) (*dto.EventResponses, error)
+	// GetAllManagedEventsByOrgID and GetManagedEventByID also return the events hidden by
+	// moderation or of a suspended organization, for the organization's own routes
+	GetAllManagedEventsByOrgID(orgID uint) ([]dto.EventResponses, error)
+	GetManagedEventByID(orgID uint, eventID uint) (*dto.EventResponses, error)
 	ListAllCategories() (*dto.CategoryListResponse, error)
 	GetEventPaginate(page uint) ([]dto.EventDocumentDTOResponse, error)
 	GetFirst() (*dto.EventResponses, error)
@@ -44,6 +48,7 @@ func requestConvertToEvent(orgID uint, reqEvent dto.NewEventRequest, categories
 		Audience:        reqEvent.Audience,
 		PriceType:       reqEvent.PriceType,
 		RegisterLink:    reqEvent.RegisterLink,
+		Capacity:        reqEvent.Capacity,
 		Status:          reqEvent.Status,
 		Categories:      categories,
 		ContactChannels: contacts,
@@ -93,6 +98,7 @@ func ConvertToEventResponse(event models.Event) dto.EventResponses {
 		Audience:        event.Audience,
 		PriceType:       event.PriceType,
 		RegisterLink:    event.RegisterLink,
+		Capacity:        event.Capacity,
 		Status:          event.Status,
 		Categories:      categories,
 		ContactChannels: contacts,
@@ -137,6 +137,50 @@ func (m *EventServiceMock) GetAllEventsByOrgID(orgID uint) ([]dto.EventResponses
 	return r0, r1
 }
 
+func (m *EventServiceMock) GetAllManagedEventsByOrgID(orgID uint) ([]dto.EventResponses, error) {
+	ret := m.Called(orgID)
+
+	var r0 []dto.EventResponses
+	if rf, ok := ret.Get(0).(func(uint) []dto.EventResponses); ok {
+		r0 = rf(orgID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dto.EventResponses)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(orgID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (m *EventServiceMock) GetManagedEventByID(orgID uint, eventID uint) (*dto.EventResponses, error) {
+	ret := m.Called(orgID, eventID)
+
+	var r0 *dto.EventResponses
+	if rf, ok := ret.Get(0).(func(uint, uint) *dto.EventResponses); ok {
+		r0 = rf(orgID, eventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dto.EventResponses)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(orgID, eventID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 func (m *EventServiceMock) GetEventPaginate(page uint) ([]dto.EventDocumentDTOResponse, error) {
 	ret := m.Called(page)
 
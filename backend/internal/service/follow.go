@@ -0,0 +1,15 @@
+package service
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/google/uuid"
+)
+
+type FollowService interface {
+	Follow(userID uuid.UUID, orgID uint) (*dto.FollowResponse, error)
+	Unfollow(userID uuid.UUID, orgID uint) (*dto.FollowResponse, error)
+	ListFollowing(userID uuid.UUID) ([]dto.OrganizationShortDocument, error)
+	// GetFeed returns up to limit events and jobs published by the organizations the user follows,
+	// newest first, starting after cursor. An empty cursor starts from the newest item.
+	GetFeed(userID uuid.UUID, cursor string, limit int) (*dto.FeedResponse, error)
+}
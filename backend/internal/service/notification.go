@@ -0,0 +1,24 @@
+package service
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type NotificationService interface {
+	ListNotifications(userID uuid.UUID, unreadOnly bool, page int) (*dto.NotificationListResponse, error)
+	GetUnreadCount(userID uuid.UUID) (*dto.UnreadCountResponse, error)
+	MarkRead(userID uuid.UUID, id uint) error
+	MarkAllRead(userID uuid.UUID) error
+}
+
+// NotificationPublisher is how the other services raise in-app notifications. Publishing never
+// fails the flow that raised it: errors are logged and the notification is dropped.
+type NotificationPublisher interface {
+	Publish(recipients []uuid.UUID, message models.NotificationMessage)
+	// PublishToOrganizationMembers notifies everyone holding a role in the organization
+	PublishToOrganizationMembers(orgID uint, message models.NotificationMessage)
+	// PublishToOrganizationFollowers notifies everyone following the organization
+	PublishToOrganizationFollowers(orgID uint, message models.NotificationMessage)
+}
@@ -16,7 +16,11 @@ type OrganizationService interface {
 	ListAllOrganizations() ([]dto.OrganizationResponse, error)
 	ListAllIndustries() (dto.IndustryListResponse, error)
 	GetOrganizationByID(orgID uint) (*dto.OrganizationResponse, error)
+	// GetPublicOrganizationByID is GetOrganizationByID for anonymous callers, not found unless approved
+	GetPublicOrganizationByID(orgID uint) (*dto.OrganizationResponse, error)
 	GetPaginateOrganization(page uint) ([]dto.OrganizationResponse, error)
+	SearchOrganizations(query dto.SearchOrganizationQuery, page int, Offset int) (dto.SearchOrganizationResponse, error)
+	SyncOrganizations() error
 	UpdateOrganization(orgID uint, org dto.OrganizationRequest, ctx context.Context, file multipart.File, fileHeader *multipart.FileHeader, file2 multipart.File, file2Header *multipart.FileHeader) (*dto.OrganizationResponse, error)
 	UpdateOrganizationStatus(orgID uint, status string) error
 	UpdateOrganizationBackgroundPicture(id uint, picURL string) error
@@ -40,6 +44,10 @@ type OrgOpenJobService interface {
 	GetAllJobsByOrgID(OrgId uint) ([]dto.JobResponses, error)
 	GetJobByID(jobID uint) (*dto.JobResponses, error)
 	GetJobByIDwithOrgID(orgID uint, jobID uint) (*dto.JobResponses, error)
+	// GetAllManagedJobsByOrgID and GetManagedJobByID also return the jobs hidden by moderation or
+	// of a suspended organization, for the organization's own routes
+	GetAllManagedJobsByOrgID(orgID uint) ([]dto.JobResponses, error)
+	GetManagedJobByID(orgID uint, jobID uint) (*dto.JobResponses, error)
 	GetJobPaginate(page uint) ([]dto.JobDocumentDTOResponse, error)
 	UpdateJob(orgID uint, jobID uint, dto dto.JobRequest) (*dto.JobResponses, error)
 	UpdateJobPicture(orgID uint, jobID uint, picURL string) error
@@ -87,6 +95,7 @@ func ConvertToOrgResponse(org models.Organization) dto.OrganizationResponse {
 		Longitude:           org.Longitude,
 		OrganizationContact: contacts,
 		Industries:          industries,
+		Status:              org.Status,
 		UpdatedAt:           org.UpdatedAt.Format("2006-01-02 15:04:05"),
 	}
 }
@@ -0,0 +1,14 @@
+package service
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/google/uuid"
+)
+
+type OrganizationReviewService interface {
+	// ListOrganizations returns a page of organizations in status, the longest waiting first
+	ListOrganizations(status string, page uint) ([]dto.OrganizationResponse, error)
+	// Review moves a pending organization to decision and notifies its owners
+	Review(reviewerID uuid.UUID, orgID uint, decision string, reason string) (*dto.OrganizationReviewResponse, error)
+	ListReviews(orgID uint) ([]dto.OrganizationReviewResponse, error)
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type ReportService interface {
+	CreateReport(reporterID uuid.UUID, req dto.ReportRequest) (*dto.ReportResponse, error)
+}
+
+type ModerationService interface {
+	// ListReports returns a page of reports in status, the longest waiting first
+	ListReports(status models.ReportStatus, targetType models.ReportTargetType, page int) (*dto.PaginatedReportsResponse, error)
+	GetReport(reportID uint) (*dto.ReportDetailResponse, error)
+	// Dismiss closes the open reports on the target of the report without acting on it
+	Dismiss(moderatorID uuid.UUID, reportID uint, note string) (*dto.ModerationDecisionResponse, error)
+	// Hide takes the reported event or job down and removes it from search
+	Hide(moderatorID uuid.UUID, reportID uint, note string) (*dto.ModerationDecisionResponse, error)
+	// SuspendOrganization suspends the reported organization, or the one owning the reported
+	// event or job, and removes it and its content from search
+	SuspendOrganization(moderatorID uuid.UUID, reportID uint, note string) (*dto.ModerationDecisionResponse, error)
+	// Reinstate reverses the hide or suspension an actioned report led to, the content is indexed
+	// again by the CDC consumer
+	Reinstate(moderatorID uuid.UUID, reportID uint, note string) (*dto.ModerationDecisionResponse, error)
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/google/uuid"
+)
+
+type SavedSearchService interface {
+	CreateSavedSearch(userID uuid.UUID, req dto.SavedSearchRequest) (*dto.SavedSearchResponse, error)
+	ListSavedSearches(userID uuid.UUID) ([]dto.SavedSearchResponse, error)
+	GetSavedSearch(userID uuid.UUID, id uint) (*dto.SavedSearchResponse, error)
+	UpdateSavedSearch(userID uuid.UUID, id uint, req dto.UpdateSavedSearchRequest) (*dto.SavedSearchResponse, error)
+	DeleteSavedSearch(userID uuid.UUID, id uint) error
+	Unsubscribe(token string) error
+	// SendDueDigests re-runs every saved search that is due and mails each user one digest
+	// with the results they have not been sent before. The results of a digest that fails to
+	// send are carried by the next one.
+	SendDueDigests(now time.Time) error
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type SessionService interface {
+	// Issue opens a new session for the user and returns its first token pair
+	Issue(user *models.User, client SessionClient) (*AuthTokens, error)
+	// Refresh exchanges a refresh token for a new pair. A token can be used only once;
+	// presenting it again means it leaked, so the whole session is revoked.
+	Refresh(refreshToken string) (*AuthTokens, error)
+	// RevokeByRefreshToken ends the session the refresh token belongs to
+	RevokeByRefreshToken(refreshToken string) error
+	RevokeSession(sessionID uuid.UUID) error
+	// RevokeAll logs the user out of every device
+	RevokeAll(userID uuid.UUID) error
+	// IsSessionActive is consulted by the auth middleware on every request, and by open streams on
+	// every heartbeat. The session of a suspended user is not active.
+	IsSessionActive(sessionID uuid.UUID) (bool, error)
+}
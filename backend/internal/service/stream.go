@@ -0,0 +1,19 @@
+package service
+
+import "github.com/google/uuid"
+
+// Names of the events pushed on /users/me/stream
+const (
+	StreamEventNotification = "notification"
+	StreamEventInvitation   = "invitation"
+	// StreamEventTicketCheckIn goes to the ticket holder with a TicketPurchasedResponse
+	StreamEventTicketCheckIn = "ticket-check-in"
+	// StreamEventAttendance goes to the members of the organization with a CheckInResponse
+	StreamEventAttendance = "event-check-in"
+)
+
+// LiveEventPublisher pushes events to the open streams of users. Users without an open stream
+// only get the event if they reconnect while it is still kept for replay.
+type LiveEventPublisher interface {
+	SendToUsers(userIDs []uuid.UUID, name string, data interface{})
+}
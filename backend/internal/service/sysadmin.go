@@ -0,0 +1,29 @@
+package service
+
+import (
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/google/uuid"
+)
+
+type SystemAdminService interface {
+	// SearchUsers returns a page of users whose name or email contains query
+	SearchUsers(query string, page int) (*dto.PaginatedSysAdminUsersResponse, error)
+	// SuspendUser blocks the user from logging in and ends all of their sessions
+	SuspendUser(adminID uuid.UUID, userID uuid.UUID, reason string) (*dto.SysAdminUserResponse, error)
+	ReinstateUser(userID uuid.UUID) (*dto.SysAdminUserResponse, error)
+	ForceLogout(userID uuid.UUID) error
+	GrantSystemAdmin(userID uuid.UUID) (*dto.SysAdminUserResponse, error)
+	RevokeSystemAdmin(adminID uuid.UUID, userID uuid.UUID) (*dto.SysAdminUserResponse, error)
+
+	ListCategories() ([]dto.SysAdminCategoryResponse, error)
+	CreateCategory(req dto.SysAdminCategoryRequest) (*dto.SysAdminCategoryResponse, error)
+	UpdateCategory(categoryID uint, req dto.SysAdminCategoryRequest) (*dto.SysAdminCategoryResponse, error)
+	DeleteCategory(categoryID uint) error
+
+	ListIndustries() ([]dto.IndustryResponses, error)
+	CreateIndustry(req dto.IndustryRequest) (*dto.IndustryResponses, error)
+	UpdateIndustry(industryID uint, req dto.IndustryRequest) (*dto.IndustryResponses, error)
+	DeleteIndustry(industryID uint) error
+
+	GetPlatformStats() (*dto.PlatformStatsResponse, error)
+}
@@ -22,6 +22,12 @@ type UserService interface {
 	UpdateUserPicture(ctx context.Context, userID uuid.UUID, file multipart.File, fileHeader *multipart.FileHeader) (string, error)
 }
 
+type PublicProfileService interface {
+	GetPublicProfile(userID uuid.UUID) (*dto.PublicProfileResponse, error)
+	GetProfileVisibility(userID uuid.UUID) (*dto.ProfileVisibilityResponse, error)
+	UpdateProfileVisibility(userID uuid.UUID, req dto.ProfileVisibilityRequest) (*dto.ProfileVisibilityResponse, error)
+}
+
 type UserPreferenceService interface {
 	CreateUserPreference(userID uuid.UUID, req dto.UserPreferenceRequest) error
 	GetUserPreference(userID uuid.UUID) (dto.UserPreferenceResponse, error)
@@ -72,15 +78,16 @@ func convertToProfileResponse(profile *models.Profile) *dto.ProfileResponses {
 	user := convertToUserResponses(&profile.User)
 
 	return &dto.ProfileResponses{
-		ID:        user.ID,
-		FirstName: profile.FirstName,
-		LastName:  profile.LastName,
-		Email:     user.Email,
-		Phone:     profile.Phone,
-		Language:  profile.Language,
-		PicUrl:    profile.PicUrl,
-		Role:      user.Role,
-		UpdateAt:  profile.UpdatedAt.Format("2006-01-02T15:04:05"),
+		ID:          user.ID,
+		FirstName:   profile.FirstName,
+		LastName:    profile.LastName,
+		Email:       user.Email,
+		Phone:       profile.Phone,
+		Language:    profile.Language,
+		PicUrl:      profile.PicUrl,
+		Role:        user.Role,
+		UpdateAt:    profile.UpdatedAt.Format("2006-01-02T15:04:05"),
+		Experiences: dto.BuildExperienceResponses(profile.Experiences),
 	}
 }
 
@@ -78,14 +78,14 @@ func TestOrganizationHandlerIntegrationService(t *testing.T) {
 		// Integration interface
 		organizationRepo := repository.NewOrganizationRepositoryMock()
 		casbinRoleRepository := repository.NewCasbinRoleRepository(initializers.Enforcer)
-		organizationService := service.NewOrganizationService(organizationRepo, casbinRoleRepository, initializers.S3)
+		organizationService := service.NewOrganizationService(organizationRepo, casbinRoleRepository, initializers.DB, initializers.ESClient, initializers.S3, nil)
 		organizationHandler := handler.NewOrganizationHandler(organizationService)
 
 		// rbac := middleware.NewRBACMiddleware(initializers.Enforcer)
 		// enforceMiddlewareWithOrganization := rbac.EnforceMiddlewareWithResources("Organization")
 
 		app := fiber.New()
-		org := app.Group("/admin/orgs", middleware.AuthMiddleware(jwtSecret))
+		org := app.Group("/admin/orgs", middleware.AuthMiddleware(jwtSecret, nil))
 		// app.Get("/orgs/get/:id", middleware.AuthMiddleware("testSecret"), organizationHandler.GetOrganizationByID)
 		org.Get("/get/:orgID", organizationHandler.GetOrganizationByID)
 
@@ -160,13 +160,13 @@ func TestOrganizationHandlerIntegrationService(t *testing.T) {
 		orgRepo := repository.NewOrganizationRepositoryMock()
 		preqRepo := repository.NewPrerequisiteRepositoryMock()
 		jobSrv := service.NewOrgOpenJobService(jobRepo, orgRepo, preqRepo, test.DB_TEST, initializers.ESClient, initializers.S3)
-		jobHandler := handler.NewOrgOpenJobHandler(jobSrv)
+		jobHandler := handler.NewOrgOpenJobHandler(jobSrv, nil)
 
 		// rbac := middleware.NewRBACMiddleware(initializers.Enforcer)
 		// enforceMiddlewareWithOpenJob := rbac.EnforceMiddlewareWithResources("OrganizationOpenJob")
 
 		app := fiber.New()
-		org := app.Group("/admin/orgs", middleware.AuthMiddleware(jwtSecret))
+		org := app.Group("/admin/orgs", middleware.AuthMiddleware(jwtSecret, nil))
 		// app.Get("/orgs/:orgID/jobs/get/:id", middleware.AuthMiddleware("testSecret"), jobHandler.GetOrgOpenJobByIDwithOrgID)
 		org.Get("/:orgID/jobs/get/:id", jobHandler.GetOrgOpenJobByIDwithOrgID)
 
@@ -0,0 +1,45 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"testing"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplicationStatus(t *testing.T) {
+	t.Run("TestFollowPipeline", func(t *testing.T) {
+		assert.True(t, models.ApplicationStatusSubmitted.CanTransitionTo(models.ApplicationStatusReviewing))
+		assert.True(t, models.ApplicationStatusReviewing.CanTransitionTo(models.ApplicationStatusInterview))
+		assert.True(t, models.ApplicationStatusInterview.CanTransitionTo(models.ApplicationStatusOffered))
+	})
+
+	t.Run("TestRejectFromAnyOpenStatus", func(t *testing.T) {
+		for _, status := range []models.ApplicationStatus{
+			models.ApplicationStatusSubmitted,
+			models.ApplicationStatusReviewing,
+			models.ApplicationStatusInterview,
+		} {
+			assert.True(t, status.CanTransitionTo(models.ApplicationStatusRejected), status)
+		}
+	})
+
+	t.Run("TestCannotSkipSteps", func(t *testing.T) {
+		assert.False(t, models.ApplicationStatusSubmitted.CanTransitionTo(models.ApplicationStatusOffered))
+		assert.False(t, models.ApplicationStatusSubmitted.CanTransitionTo(models.ApplicationStatusInterview))
+		assert.False(t, models.ApplicationStatusInterview.CanTransitionTo(models.ApplicationStatusReviewing))
+	})
+
+	t.Run("TestFinalStatuses", func(t *testing.T) {
+		for _, status := range []models.ApplicationStatus{
+			models.ApplicationStatusOffered,
+			models.ApplicationStatusRejected,
+			models.ApplicationStatusWithdrawn,
+		} {
+			assert.False(t, status.CanTransitionTo(models.ApplicationStatusReviewing), status)
+			assert.False(t, status.CanTransitionTo(models.ApplicationStatusWithdrawn), status)
+		}
+	})
+}
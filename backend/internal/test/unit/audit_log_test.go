@@ -0,0 +1,114 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/middleware"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeAuditLogRepository struct {
+	logs []models.AuditLog
+}
+
+func (r *fakeAuditLogRepository) Create(log *models.AuditLog) error {
+	r.logs = append(r.logs, *log)
+	return nil
+}
+
+func (r *fakeAuditLogRepository) ListByOrganization(uint, models.AuditLogFilter, int, int) ([]models.AuditLog, int64, error) {
+	return r.logs, int64(len(r.logs)), nil
+}
+
+func TestAuditMiddleware(t *testing.T) {
+	enforcer := newPolicyEnforcer(t)
+	moderatorID := uuid.New()
+	_, _ = enforcer.AddGroupingPolicy(moderatorID.String(), "moderator", "1")
+
+	repo := &fakeAuditLogRepository{}
+	event := map[string]interface{}{"name": "Meetup", "price": "free", "updatedAt": "2025-01-01"}
+	audit := middleware.NewAuditMiddleware(repo).
+		Snapshot("Event", func(c *fiber.Ctx, orgID uint) (interface{}, error) {
+			if event == nil {
+				return nil, fiber.ErrNotFound
+			}
+			return event, nil
+		})
+	rbac := middleware.NewRBACMiddleware(enforcer).WithAudit(audit)
+
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("user", jwt.MapClaims{"user_id": moderatorID.String()})
+		return c.Next()
+	})
+	app.Get("/admin/orgs/:orgID/events/:id", rbac.EnforceMiddleware("Event", "read"), func(c *fiber.Ctx) error {
+		return c.JSON(event)
+	})
+	app.Put("/admin/orgs/:orgID/events/:id", rbac.EnforceMiddleware("Event", "update"), func(c *fiber.Ctx) error {
+		if strings.Contains(string(c.Body()), "invalid") {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid"})
+		}
+		event = map[string]interface{}{"name": "Meetup 2", "price": "free", "updatedAt": "2025-01-02"}
+		return c.JSON(event)
+	})
+	app.Delete("/admin/orgs/:orgID/events/:id", rbac.EnforceMiddleware("Event", "delete"), func(c *fiber.Ctx) error {
+		event = nil
+		return c.JSON(fiber.Map{"message": "deleted"})
+	})
+
+	send := func(method string, path string, body string) int {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+		res, err := app.Test(req)
+		assert.NoError(t, err)
+		return res.StatusCode
+	}
+
+	t.Run("reads are not recorded", func(t *testing.T) {
+		assert.Equal(t, fiber.StatusOK, send(fiber.MethodGet, "/admin/orgs/1/events/7", ""))
+		assert.Empty(t, repo.logs)
+	})
+
+	t.Run("denied and failed requests are not recorded", func(t *testing.T) {
+		assert.Equal(t, fiber.StatusForbidden, send(fiber.MethodPut, "/admin/orgs/2/events/7", `{}`))
+		assert.Equal(t, fiber.StatusBadRequest, send(fiber.MethodPut, "/admin/orgs/1/events/7", `{"name":"invalid"}`))
+		assert.Empty(t, repo.logs)
+	})
+
+	t.Run("an update records the fields that changed", func(t *testing.T) {
+		assert.Equal(t, fiber.StatusOK, send(fiber.MethodPut, "/admin/orgs/1/events/7", `{"name":"Meetup 2"}`))
+		if assert.Len(t, repo.logs, 1) {
+			log := repo.logs[0]
+			assert.Equal(t, moderatorID, log.ActorID)
+			assert.Equal(t, uint(1), log.OrganizationID)
+			assert.Equal(t, "Event", log.Resource)
+			assert.Equal(t, "update", log.Action)
+			assert.Equal(t, "/admin/orgs/1/events/7", log.Path)
+
+			var changes map[string]map[string]interface{}
+			assert.NoError(t, json.Unmarshal([]byte(log.Changes), &changes))
+			assert.Equal(t, map[string]map[string]interface{}{
+				"name": {"before": "Meetup", "after": "Meetup 2"},
+			}, changes)
+		}
+	})
+
+	t.Run("a delete records the state that was removed", func(t *testing.T) {
+		assert.Equal(t, fiber.StatusOK, send(fiber.MethodDelete, "/admin/orgs/1/events/7", ""))
+		if assert.Len(t, repo.logs, 2) {
+			var changes map[string]map[string]interface{}
+			assert.NoError(t, json.Unmarshal([]byte(repo.logs[1].Changes), &changes))
+			assert.Equal(t, map[string]interface{}{"before": "Meetup 2", "after": nil}, changes["name"])
+			assert.Equal(t, map[string]interface{}{"before": "free", "after": nil}, changes["price"])
+		}
+	})
+}
@@ -0,0 +1,392 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
+	"github.com/glebarez/sqlite"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// visibilityFixture holds, for an approved and a suspended organization, one visible and one
+// hidden event and job each
+type visibilityFixture struct {
+	db                  *gorm.DB
+	approved, suspended models.Organization
+	events              map[string]models.Event
+	jobs                map[string]models.OrgOpenJob
+}
+
+// newSQLiteDB opens an in-memory database with the tables of models
+func newSQLiteDB(t *testing.T, models ...interface{}) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+		Logger:                                   logger.Default.LogMode(logger.Silent),
+		DisableForeignKeyConstraintWhenMigrating: true,
+	})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	// Every connection opens its own in-memory database
+	sqlDB.SetMaxOpenConns(1)
+	require.NoError(t, db.AutoMigrate(models...))
+
+	return db
+}
+
+func newVisibilityFixture(t *testing.T) visibilityFixture {
+	db := newSQLiteDB(t, &models.Organization{}, &models.Event{}, &models.OrgOpenJob{},
+		&models.ContactChannel{}, &models.Prerequisite{}, &models.OrganizationContact{}, &models.Industry{})
+	// Unlike AutoMigrate, CreateTable leaves out the users table, whose defaults are postgres only
+	require.NoError(t, db.Migrator().CreateTable(&models.Report{}, &models.ModerationDecision{},
+		&models.OrganizationFollow{}, &models.Bookmark{}, &models.EventParticipant{}))
+
+	f := visibilityFixture{
+		db:        db,
+		approved:  models.Organization{Name: "Approved", Email: "approved@example.com", Status: models.OrgStatusApproved},
+		suspended: models.Organization{Name: "Suspended", Email: "suspended@example.com", Status: models.OrgStatusSuspended},
+		events:    make(map[string]models.Event),
+		jobs:      make(map[string]models.OrgOpenJob),
+	}
+	require.NoError(t, db.Create(&f.approved).Error)
+	require.NoError(t, db.Create(&f.suspended).Error)
+
+	hiddenAt := time.Now()
+	publishedAt := time.Now()
+	for _, org := range []models.Organization{f.approved, f.suspended} {
+		for _, hidden := range []bool{false, true} {
+			name := org.Name
+			event := models.Event{
+				Name:           name,
+				StartDate:      utils.DateOnly{Time: time.Now()},
+				Status:         string(models.Published),
+				PublishedAt:    &publishedAt,
+				OrganizationID: org.ID,
+			}
+			job := models.OrgOpenJob{
+				Title:          name,
+				Workplace:      models.WorkplaceRemote,
+				WorkType:       models.WorkTypeFullTime,
+				CareerStage:    models.CareerStageJunior,
+				Status:         string(models.JobStatusPublished),
+				PublishedAt:    &publishedAt,
+				OrganizationID: org.ID,
+			}
+			if hidden {
+				name += " hidden"
+				event.Name, job.Title = name, name
+				event.HiddenAt, job.HiddenAt = &hiddenAt, &hiddenAt
+			}
+			require.NoError(t, db.Create(&event).Error)
+			require.NoError(t, db.Create(&job).Error)
+			f.events[name] = event
+			f.jobs[name] = job
+		}
+	}
+
+	return f
+}
+
+func eventNames(events []models.Event) []string {
+	names := make([]string, 0, len(events))
+	for _, event := range events {
+		names = append(names, event.Name)
+	}
+	return names
+}
+
+func jobTitles(jobs []models.OrgOpenJob) []string {
+	titles := make([]string, 0, len(jobs))
+	for _, job := range jobs {
+		titles = append(titles, job.Title)
+	}
+	return titles
+}
+
+func TestContentVisibility(t *testing.T) {
+	t.Run("TestEventListings", func(t *testing.T) {
+		f := newVisibilityFixture(t)
+		repo := repository.NewEventRepository(f.db)
+
+		all, err := repo.GetAll()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Approved"}, eventNames(all))
+
+		page, err := repo.GetPaginate(1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Approved"}, eventNames(page))
+	})
+
+	t.Run("TestJobListings", func(t *testing.T) {
+		f := newVisibilityFixture(t)
+		repo := repository.NewOrgOpenJobRepository(f.db)
+
+		all, err := repo.GetAllJobs()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Approved"}, jobTitles(all))
+
+		page, err := repo.GetJobsPaginate(1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Approved"}, jobTitles(page))
+	})
+
+	t.Run("TestEventsOfOrganization", func(t *testing.T) {
+		f := newVisibilityFixture(t)
+		eventService := service.NewEventService(repository.NewEventRepository(f.db), nil, f.db, nil, nil, nil)
+
+		public, err := eventService.GetAllEventsByOrgID(f.approved.ID)
+		require.NoError(t, err)
+		assert.Len(t, public, 1)
+		public, err = eventService.GetAllEventsByOrgID(f.suspended.ID)
+		require.NoError(t, err)
+		assert.Empty(t, public)
+
+		managed, err := eventService.GetAllManagedEventsByOrgID(f.suspended.ID)
+		require.NoError(t, err)
+		assert.Len(t, managed, 2)
+
+		for name, event := range f.events {
+			_, err := eventService.GetEventByIDwithOrgID(event.OrganizationID, event.ID)
+			_, byIDErr := eventService.GetEventByID(event.ID)
+			if name == "Approved" {
+				assert.NoError(t, err, name)
+				assert.NoError(t, byIDErr, name)
+			} else {
+				assert.IsType(t, errs.AppError{}, err, name)
+				assert.IsType(t, errs.AppError{}, byIDErr, name)
+			}
+
+			_, err = eventService.GetManagedEventByID(event.OrganizationID, event.ID)
+			assert.NoError(t, err, name)
+		}
+	})
+
+	t.Run("TestFollowedFeed", func(t *testing.T) {
+		f := newVisibilityFixture(t)
+		repo := repository.NewOrganizationFollowRepository(f.db)
+		userID := uuid.New()
+		for _, org := range []models.Organization{f.approved, f.suspended} {
+			require.NoError(t, repo.Follow(&models.OrganizationFollow{UserID: userID, OrganizationID: org.ID}))
+		}
+
+		events, err := repo.ListFeedEvents(userID, nil, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Approved"}, eventNames(events))
+
+		jobs, err := repo.ListFeedJobs(userID, nil, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Approved"}, jobTitles(jobs))
+	})
+
+	t.Run("TestBookmarks", func(t *testing.T) {
+		f := newVisibilityFixture(t)
+		repo := repository.NewBookmarkRepository(f.db)
+
+		for name, event := range f.events {
+			exists, err := repo.TargetExists(models.BookmarkTargetEvent, event.ID)
+			require.NoError(t, err)
+			assert.Equal(t, name == "Approved", exists, name)
+		}
+		for title, job := range f.jobs {
+			exists, err := repo.TargetExists(models.BookmarkTargetJob, job.ID)
+			require.NoError(t, err)
+			assert.Equal(t, title == "Approved", exists, title)
+		}
+		exists, err := repo.TargetExists(models.BookmarkTargetOrganization, f.suspended.ID)
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		userID := uuid.New()
+		for _, event := range f.events {
+			require.NoError(t, repo.Create(&models.Bookmark{UserID: userID, TargetType: models.BookmarkTargetEvent, TargetID: event.ID}))
+		}
+		for _, job := range f.jobs {
+			require.NoError(t, repo.Create(&models.Bookmark{UserID: userID, TargetType: models.BookmarkTargetJob, TargetID: job.ID}))
+		}
+		require.NoError(t, repo.Create(&models.Bookmark{UserID: userID, TargetType: models.BookmarkTargetOrganization, TargetID: f.suspended.ID}))
+
+		bookmarks, err := service.NewBookmarkService(repo).ListBookmarks(userID, "")
+		require.NoError(t, err)
+		require.Len(t, bookmarks, 2)
+		for _, bookmark := range bookmarks {
+			if bookmark.Event != nil {
+				assert.Equal(t, f.events["Approved"].ID, bookmark.TargetID)
+			} else {
+				assert.Equal(t, f.jobs["Approved"].ID, bookmark.TargetID)
+			}
+		}
+	})
+
+	t.Run("TestProfileParticipations", func(t *testing.T) {
+		f := newVisibilityFixture(t)
+		userID := uuid.New()
+		for _, event := range f.events {
+			require.NoError(t, f.db.Create(&models.EventParticipant{UserId: userID, EventId: event.ID, IsVisible: true}).Error)
+		}
+
+		participations, err := repository.NewEventParticipantRepository(f.db).GetVisibleByUserID(userID)
+		require.NoError(t, err)
+		require.Len(t, participations, 1)
+		assert.Equal(t, "Approved", participations[0].Event.Name)
+	})
+
+	t.Run("TestUnapprovedOrganization", func(t *testing.T) {
+		f := newVisibilityFixture(t)
+		orgRepo := repository.NewOrganizationRepository(f.db)
+		pending := models.Organization{Name: "Pending", Email: "pending@example.com", Status: models.OrgStatusPending}
+		require.NoError(t, f.db.Create(&pending).Error)
+		event := models.Event{Name: "Pending", StartDate: utils.DateOnly{Time: time.Now()}, OrganizationID: pending.ID}
+		require.NoError(t, f.db.Create(&event).Error)
+
+		all, err := repository.NewEventRepository(f.db).GetAll()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Approved"}, eventNames(all))
+
+		organizationService := service.NewOrganizationService(orgRepo, nil, f.db, nil, nil, nil)
+		_, err = organizationService.GetPublicOrganizationByID(pending.ID)
+		assert.Equal(t, errs.NewNotFoundError("organization not found"), err)
+		_, err = organizationService.GetOrganizationByID(pending.ID)
+		assert.NoError(t, err)
+
+		followService := service.NewFollowService(repository.NewOrganizationFollowRepository(f.db), orgRepo)
+		_, err = followService.Follow(uuid.New(), pending.ID)
+		assert.Equal(t, errs.NewNotFoundError("organization not found"), err)
+	})
+
+	t.Run("TestJobsOfOrganization", func(t *testing.T) {
+		f := newVisibilityFixture(t)
+		jobService := service.NewOrgOpenJobService(repository.NewOrgOpenJobRepository(f.db), nil, nil, nil, f.db, nil, nil)
+
+		public, err := jobService.GetAllJobsByOrgID(f.approved.ID)
+		require.NoError(t, err)
+		assert.Len(t, public, 1)
+		public, err = jobService.GetAllJobsByOrgID(f.suspended.ID)
+		require.NoError(t, err)
+		assert.Empty(t, public)
+
+		managed, err := jobService.GetAllManagedJobsByOrgID(f.suspended.ID)
+		require.NoError(t, err)
+		assert.Len(t, managed, 2)
+
+		for title, job := range f.jobs {
+			_, err := jobService.GetJobByIDwithOrgID(job.OrganizationID, job.ID)
+			_, byIDErr := jobService.GetJobByID(job.ID)
+			if title == "Approved" {
+				assert.NoError(t, err, title)
+				assert.NoError(t, byIDErr, title)
+			} else {
+				assert.IsType(t, errs.AppError{}, err, title)
+				assert.IsType(t, errs.AppError{}, byIDErr, title)
+			}
+
+			_, err = jobService.GetManagedJobByID(job.OrganizationID, job.ID)
+			assert.NoError(t, err, title)
+		}
+	})
+}
+
+func TestModerationReinstate(t *testing.T) {
+	// actionedReport stores a report closed by a decision taking action on the target
+	actionedReport := func(t *testing.T, db *gorm.DB, target models.ReportTargetType, targetID uint,
+		action models.ModerationAction, orgID uint) models.Report {
+		decision := models.ModerationDecision{
+			ModeratorID:    uuid.New(),
+			Action:         action,
+			TargetType:     target,
+			TargetID:       strconv.FormatUint(uint64(targetID), 10),
+			OrganizationID: &orgID,
+		}
+		require.NoError(t, db.Create(&decision).Error)
+		report := models.Report{
+			ReporterID: uuid.New(),
+			TargetType: target,
+			TargetID:   decision.TargetID,
+			Reason:     "spam",
+			Status:     models.ReportStatusActioned,
+			DecisionID: &decision.ID,
+		}
+		require.NoError(t, db.Create(&report).Error)
+		return report
+	}
+
+	t.Run("TestUnhide", func(t *testing.T) {
+		f := newVisibilityFixture(t)
+		moderation := service.NewModerationService(repository.NewReportRepository(f.db), nil, nil)
+		event := f.events["Approved hidden"]
+		report := actionedReport(t, f.db, models.ReportTargetEvent, event.ID, models.ModerationHide, f.approved.ID)
+
+		decision, err := moderation.Reinstate(uuid.New(), report.ID, "appeal accepted")
+		require.NoError(t, err)
+		assert.Equal(t, string(models.ModerationReinstate), decision.Action)
+
+		var reloaded models.Event
+		require.NoError(t, f.db.First(&reloaded, event.ID).Error)
+		assert.Nil(t, reloaded.HiddenAt)
+
+		_, err = moderation.Reinstate(uuid.New(), report.ID, "twice")
+		assert.Equal(t, errs.NewConflictError("event is not hidden or suspended anymore"), err)
+	})
+
+	t.Run("TestReinstateOrganization", func(t *testing.T) {
+		f := newVisibilityFixture(t)
+		moderation := service.NewModerationService(repository.NewReportRepository(f.db), nil, nil)
+		job := f.jobs["Suspended"]
+		report := actionedReport(t, f.db, models.ReportTargetJob, job.ID, models.ModerationSuspendOrg, f.suspended.ID)
+
+		_, err := moderation.Reinstate(uuid.New(), report.ID, "")
+		require.NoError(t, err)
+
+		jobs, err := repository.NewOrgOpenJobRepository(f.db).GetAllJobs()
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"Approved", "Suspended"}, jobTitles(jobs))
+	})
+
+	t.Run("TestReinstateRestoresPreviousStatus", func(t *testing.T) {
+		f := newVisibilityFixture(t)
+		reportRepo := repository.NewReportRepository(f.db)
+		moderation := service.NewModerationService(reportRepo, nil, nil)
+		pending := models.Organization{Name: "Pending", Email: "pending@example.com", Status: models.OrgStatusPending}
+		require.NoError(t, f.db.Create(&pending).Error)
+		targetID := strconv.FormatUint(uint64(pending.ID), 10)
+		report := models.Report{ReporterID: uuid.New(), TargetType: models.ReportTargetOrganization, TargetID: targetID, Reason: "spam"}
+		require.NoError(t, f.db.Create(&report).Error)
+
+		decision := models.ModerationDecision{
+			ModeratorID:    uuid.New(),
+			Action:         models.ModerationSuspendOrg,
+			TargetType:     models.ReportTargetOrganization,
+			TargetID:       targetID,
+			OrganizationID: &pending.ID,
+		}
+		require.NoError(t, reportRepo.SuspendOrganization(&decision))
+		assert.Equal(t, models.OrgStatusPending, decision.OrgStatusBefore)
+
+		_, err := moderation.Reinstate(uuid.New(), report.ID, "")
+		require.NoError(t, err)
+
+		var reloaded models.Organization
+		require.NoError(t, f.db.First(&reloaded, pending.ID).Error)
+		assert.Equal(t, models.OrgStatusPending, reloaded.Status)
+	})
+
+	t.Run("TestOpenReport", func(t *testing.T) {
+		f := newVisibilityFixture(t)
+		moderation := service.NewModerationService(repository.NewReportRepository(f.db), nil, nil)
+		report := models.Report{ReporterID: uuid.New(), TargetType: models.ReportTargetEvent, TargetID: "1", Reason: "spam"}
+		require.NoError(t, f.db.Create(&report).Error)
+
+		_, err := moderation.Reinstate(uuid.New(), report.ID, "")
+		assert.Equal(t, errs.NewConflictError("only an actioned report can be reinstated"), err)
+	})
+}
@@ -48,7 +48,7 @@ func TestEventHandler(t *testing.T) {
 
 		eventService := service.NewEventServiceMock()
 		eventService.On("GetEventByIDwithOrgID", uint(organizationID), uint(eventID)).Return(&expectedResponse, nil)
-		eventHandler := handler.NewEventHandler(eventService)
+		eventHandler := handler.NewEventHandler(eventService, nil)
 
 		app := fiber.New()
 		app.Get("/orgs/:orgID/events/:id", eventHandler.GetEventByIDwithOrgID)
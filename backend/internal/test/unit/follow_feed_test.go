@@ -0,0 +1,180 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/dto"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// feedFixture has a user following one organization that published events and jobs, several of
+// them at the same instant
+type feedFixture struct {
+	db      *gorm.DB
+	service service.FollowService
+	userID  uuid.UUID
+	// want is the whole feed, as kind:ID in feed order
+	want []string
+}
+
+func newFeedFixture(t *testing.T) feedFixture {
+	db := newSQLiteDB(t, &models.Organization{}, &models.Event{}, &models.OrgOpenJob{},
+		&models.Category{}, &models.Prerequisite{})
+	require.NoError(t, db.Migrator().CreateTable(&models.OrganizationFollow{}))
+
+	followed := models.Organization{Name: "Followed", Email: "followed@example.com", Status: models.OrgStatusApproved}
+	other := models.Organization{Name: "Other", Email: "other@example.com", Status: models.OrgStatusApproved}
+	require.NoError(t, db.Create(&followed).Error)
+	require.NoError(t, db.Create(&other).Error)
+
+	f := feedFixture{
+		db:      db,
+		service: service.NewFollowService(repository.NewOrganizationFollowRepository(db), repository.NewOrganizationRepository(db)),
+		userID:  uuid.New(),
+	}
+	require.NoError(t, db.Create(&models.OrganizationFollow{UserID: f.userID, OrganizationID: followed.ID}).Error)
+
+	// The cursor keeps microseconds, like postgres
+	base := time.Now().Truncate(time.Microsecond)
+	newer := base.Add(1500 * time.Microsecond)
+	older := base.Add(-time.Second)
+	createEvent := func(org models.Organization, publishedAt time.Time) models.Event {
+		event := models.Event{
+			Name:           org.Name,
+			StartDate:      utils.DateOnly{Time: time.Now()},
+			Status:         string(models.Published),
+			PublishedAt:    &publishedAt,
+			OrganizationID: org.ID,
+		}
+		require.NoError(t, db.Create(&event).Error)
+		return event
+	}
+	createJob := func(org models.Organization, publishedAt time.Time) models.OrgOpenJob {
+		job := models.OrgOpenJob{
+			Title:          org.Name,
+			Workplace:      models.WorkplaceRemote,
+			WorkType:       models.WorkTypeFullTime,
+			CareerStage:    models.CareerStageJunior,
+			Status:         string(models.JobStatusPublished),
+			PublishedAt:    &publishedAt,
+			OrganizationID: org.ID,
+		}
+		require.NoError(t, db.Create(&job).Error)
+		return job
+	}
+
+	// Created in an order unrelated to the feed order
+	e1 := createEvent(followed, base)
+	j1 := createJob(followed, older)
+	e2 := createEvent(followed, newer)
+	j2 := createJob(followed, base)
+	e3 := createEvent(followed, base)
+	j3 := createJob(followed, base)
+	e4 := createEvent(followed, older)
+	j4 := createJob(followed, newer)
+	createEvent(other, base)
+	createJob(other, base)
+	draft := createEvent(followed, base)
+	require.NoError(t, db.Model(&draft).Update("status", models.Draft).Error)
+
+	// Newest first; at the same instant jobs come before events, each by descending ID
+	f.want = []string{
+		fmt.Sprintf("job:%d", j4.ID),
+		fmt.Sprintf("event:%d", e2.ID),
+		fmt.Sprintf("job:%d", j3.ID),
+		fmt.Sprintf("job:%d", j2.ID),
+		fmt.Sprintf("event:%d", e3.ID),
+		fmt.Sprintf("event:%d", e1.ID),
+		fmt.Sprintf("job:%d", j1.ID),
+		fmt.Sprintf("event:%d", e4.ID),
+	}
+
+	return f
+}
+
+func feedKeys(items []dto.FeedItemResponse) []string {
+	keys := make([]string, 0, len(items))
+	for _, item := range items {
+		if item.Event != nil {
+			keys = append(keys, fmt.Sprintf("event:%d", item.Event.ID))
+		} else {
+			keys = append(keys, fmt.Sprintf("job:%d", item.Job.ID))
+		}
+	}
+	return keys
+}
+
+func TestFollowFeed(t *testing.T) {
+	t.Run("TestOrder", func(t *testing.T) {
+		f := newFeedFixture(t)
+
+		feed, err := f.service.GetFeed(f.userID, "", 20)
+		require.NoError(t, err)
+		assert.Equal(t, f.want, feedKeys(feed.Items))
+		assert.Empty(t, feed.NextCursor)
+	})
+
+	t.Run("TestPaging", func(t *testing.T) {
+		f := newFeedFixture(t)
+
+		// Every page size puts the page boundary inside a group of items published together
+		for limit := 1; limit <= len(f.want); limit++ {
+			t.Run(fmt.Sprintf("Limit%d", limit), func(t *testing.T) {
+				var keys []string
+				seen := make(map[string]bool)
+				cursor := ""
+				for pages := 0; ; pages++ {
+					require.Less(t, pages, len(f.want)+1, "the feed does not end")
+
+					feed, err := f.service.GetFeed(f.userID, cursor, limit)
+					require.NoError(t, err)
+					assert.LessOrEqual(t, len(feed.Items), limit)
+					for _, key := range feedKeys(feed.Items) {
+						assert.False(t, seen[key], "%s is on two pages", key)
+						seen[key] = true
+						keys = append(keys, key)
+					}
+
+					if feed.NextCursor == "" {
+						break
+					}
+					cursor = feed.NextCursor
+				}
+
+				assert.Equal(t, f.want, keys)
+			})
+		}
+	})
+
+	t.Run("TestLastPageHasNoCursor", func(t *testing.T) {
+		f := newFeedFixture(t)
+
+		feed, err := f.service.GetFeed(f.userID, "", len(f.want))
+		require.NoError(t, err)
+		assert.Len(t, feed.Items, len(f.want))
+		assert.Empty(t, feed.NextCursor)
+	})
+
+	t.Run("TestInvalidCursor", func(t *testing.T) {
+		f := newFeedFixture(t)
+
+		for _, cursor := range []string{"not base64!", "MTIz", "MXxwb3N0fDE"} {
+			_, err := f.service.GetFeed(f.userID, cursor, 10)
+			var appErr errs.AppError
+			require.ErrorAs(t, err, &appErr, cursor)
+			assert.Equal(t, 400, appErr.Code, cursor)
+		}
+	})
+}
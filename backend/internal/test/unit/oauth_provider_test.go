@@ -0,0 +1,117 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/pkg/oauthprovider"
+	"github.com/stretchr/testify/assert"
+)
+
+// newMockOAuthServer issues "mock-access-token" for "valid-code" and serves userInfo to that token
+func newMockOAuthServer(t *testing.T, userInfo map[string]interface{}) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil || r.FormValue("code") != "valid-code" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "mock-access-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer mock-access-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(userInfo)
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newMockProvider(name string, srv *httptest.Server) *oauthprovider.Provider {
+	return oauthprovider.New(name, oauthprovider.Definitions[name], oauthprovider.Credentials{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		RedirectURL:  "http://localhost/auth/" + name + "/callback",
+		AuthURL:      srv.URL + "/authorize",
+		TokenURL:     srv.URL + "/token",
+		UserInfoURL:  srv.URL + "/userinfo",
+	})
+}
+
+func TestOAuthProvider(t *testing.T) {
+	t.Run("TestFacebookUserInfo", func(t *testing.T) {
+		srv := newMockOAuthServer(t, map[string]interface{}{
+			"id":    "10158",
+			"name":  "Somchai Jaidee",
+			"email": "somchai@example.com",
+			"picture": map[string]interface{}{
+				"data": map[string]interface{}{"url": "https://example.com/avatar.jpg"},
+			},
+		})
+
+		info, err := newMockProvider("facebook", srv).FetchUserInfo(context.Background(), "valid-code")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "facebook", info.Provider)
+		assert.Equal(t, "10158", info.ProviderID)
+		assert.Equal(t, "Somchai Jaidee", info.Name)
+		assert.Equal(t, "somchai@example.com", info.Email)
+		assert.Equal(t, "https://example.com/avatar.jpg", info.AvatarURL)
+		assert.True(t, info.EmailVerified)
+	})
+
+	t.Run("TestGoogleUnverifiedEmail", func(t *testing.T) {
+		srv := newMockOAuthServer(t, map[string]interface{}{
+			"id":             "1234567890",
+			"name":           "Somchai Jaidee",
+			"email":          "somchai@example.com",
+			"verified_email": false,
+		})
+
+		info, err := newMockProvider("google", srv).FetchUserInfo(context.Background(), "valid-code")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "1234567890", info.ProviderID)
+		assert.False(t, info.EmailVerified)
+	})
+
+	t.Run("TestFacebookWithoutEmail", func(t *testing.T) {
+		srv := newMockOAuthServer(t, map[string]interface{}{
+			"id":   "10158",
+			"name": "Somchai Jaidee",
+		})
+
+		info, err := newMockProvider("facebook", srv).FetchUserInfo(context.Background(), "valid-code")
+
+		assert.NoError(t, err)
+		assert.Empty(t, info.Email)
+		assert.False(t, info.EmailVerified)
+	})
+
+	t.Run("TestRejectInvalidCode", func(t *testing.T) {
+		srv := newMockOAuthServer(t, map[string]interface{}{"id": "10158"})
+
+		_, err := newMockProvider("facebook", srv).FetchUserInfo(context.Background(), "invalid-code")
+
+		assert.Error(t, err)
+	})
+}
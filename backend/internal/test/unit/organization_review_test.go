@@ -0,0 +1,39 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListOrganizationsByStatus(t *testing.T) {
+	db := newSQLiteDB(t, &models.Organization{}, &models.OrganizationContact{})
+	repo := repository.NewOrganizationReviewRepository(db)
+
+	// Organizations submitted at the same time are still paged in a stable order
+	submittedAt := time.Now()
+	for i := 1; i <= 5; i++ {
+		org := models.Organization{Name: "Org " + strconv.Itoa(i), Email: strconv.Itoa(i) + "@example.com", Status: models.OrgStatusPending}
+		org.UpdatedAt = submittedAt
+		require.NoError(t, db.Create(&org).Error)
+	}
+	require.NoError(t, db.Create(&models.Organization{Name: "Approved", Email: "approved@example.com", Status: models.OrgStatusApproved}).Error)
+
+	var names []string
+	for page := uint(1); page <= 3; page++ {
+		orgs, err := repo.ListOrganizationsByStatus(models.OrgStatusPending, page, 2)
+		require.NoError(t, err)
+		for _, org := range orgs {
+			names = append(names, org.Name)
+		}
+	}
+
+	assert.Equal(t, []string{"Org 1", "Org 2", "Org 3", "Org 4", "Org 5"}, names)
+}
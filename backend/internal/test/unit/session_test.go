@@ -0,0 +1,240 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// fakeSessionRepository keeps sessions and refresh tokens in memory, with the same rotation
+// guarantee as the database: a token can be rotated only once
+type fakeSessionRepository struct {
+	mu       sync.Mutex
+	users    map[uuid.UUID]*models.User
+	sessions map[uuid.UUID]*models.UserSession
+	tokens   map[string]*models.RefreshToken
+}
+
+func newFakeSessionRepository() *fakeSessionRepository {
+	return &fakeSessionRepository{
+		users:    make(map[uuid.UUID]*models.User),
+		sessions: make(map[uuid.UUID]*models.UserSession),
+		tokens:   make(map[string]*models.RefreshToken),
+	}
+}
+
+func (r *fakeSessionRepository) Create(session *models.UserSession, refreshToken *models.RefreshToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := *session
+	r.sessions[session.ID] = &stored
+	refreshToken.ID = uuid.New()
+	refreshToken.SessionID = session.ID
+	token := *refreshToken
+	r.tokens[refreshToken.TokenHash] = &token
+	return nil
+}
+
+func (r *fakeSessionRepository) GetSessionByID(id uuid.UUID) (*models.UserSession, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	session, ok := r.sessions[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	found := *session
+	found.User = *r.users[session.UserID]
+	return &found, nil
+}
+
+func (r *fakeSessionRepository) GetRefreshTokenByHash(tokenHash string) (*models.RefreshToken, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	token, ok := r.tokens[tokenHash]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	found := *token
+	found.Session = *r.sessions[token.SessionID]
+	found.Session.User = *r.users[found.Session.UserID]
+	return &found, nil
+}
+
+func (r *fakeSessionRepository) Rotate(oldTokenID uuid.UUID, newToken *models.RefreshToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, token := range r.tokens {
+		if token.ID != oldTokenID {
+			continue
+		}
+		if token.RotatedAt != nil {
+			return models.ErrRefreshTokenReused
+		}
+		now := time.Now()
+		token.RotatedAt = &now
+		newToken.ID = uuid.New()
+		stored := *newToken
+		r.tokens[newToken.TokenHash] = &stored
+		r.sessions[newToken.SessionID].ExpiresAt = newToken.ExpiresAt
+		return nil
+	}
+	return gorm.ErrRecordNotFound
+}
+
+func (r *fakeSessionRepository) RevokeSession(id uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if session, ok := r.sessions[id]; ok && session.RevokedAt == nil {
+		now := time.Now()
+		session.RevokedAt = &now
+	}
+	return nil
+}
+
+func (r *fakeSessionRepository) RevokeAllByUserID(userID uuid.UUID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for _, session := range r.sessions {
+		if session.UserID == userID && session.RevokedAt == nil {
+			session.RevokedAt = &now
+		}
+	}
+	return nil
+}
+
+func assertAppErrorCode(t *testing.T, err error, code int) {
+	t.Helper()
+	var appErr errs.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, code, appErr.Code)
+}
+
+func TestSessionService(t *testing.T) {
+	newSession := func(t *testing.T) (*fakeSessionRepository, service.SessionService, *models.User, *service.AuthTokens) {
+		repo := newFakeSessionRepository()
+		user := &models.User{ID: uuid.New(), Email: "user@example.com"}
+		repo.users[user.ID] = user
+		sessions := service.NewSessionService(repo, "test-secret")
+
+		tokens, err := sessions.Issue(user, service.SessionClient{UserAgent: "test", IPAddress: "127.0.0.1"})
+		require.NoError(t, err)
+		return repo, sessions, user, tokens
+	}
+	onlySession := func(repo *fakeSessionRepository) *models.UserSession {
+		for _, session := range repo.sessions {
+			return session
+		}
+		return nil
+	}
+
+	t.Run("TestRefreshRotatesToken", func(t *testing.T) {
+		repo, sessions, _, first := newSession(t)
+
+		second, err := sessions.Refresh(first.RefreshToken)
+		require.NoError(t, err)
+		assert.NotEqual(t, first.RefreshToken, second.RefreshToken)
+		assert.NotEmpty(t, second.AccessToken)
+
+		third, err := sessions.Refresh(second.RefreshToken)
+		require.NoError(t, err)
+		assert.NotEqual(t, second.RefreshToken, third.RefreshToken)
+
+		// Every rotation stays in the one session, which is still active
+		require.Len(t, repo.sessions, 1)
+		active, err := sessions.IsSessionActive(onlySession(repo).ID)
+		require.NoError(t, err)
+		assert.True(t, active)
+	})
+
+	t.Run("TestReuseRevokesSession", func(t *testing.T) {
+		repo, sessions, _, first := newSession(t)
+
+		second, err := sessions.Refresh(first.RefreshToken)
+		require.NoError(t, err)
+
+		// The rotated token is presented again, as when it leaked
+		_, err = sessions.Refresh(first.RefreshToken)
+		assertAppErrorCode(t, err, http.StatusUnauthorized)
+
+		active, err := sessions.IsSessionActive(onlySession(repo).ID)
+		require.NoError(t, err)
+		assert.False(t, active)
+
+		// The successor the legitimate client holds is revoked with the session
+		_, err = sessions.Refresh(second.RefreshToken)
+		assertAppErrorCode(t, err, http.StatusUnauthorized)
+	})
+
+	t.Run("TestConcurrentRefreshRevokesSession", func(t *testing.T) {
+		repo, sessions, _, first := newSession(t)
+
+		// Two requests read the token before either rotates it: only one may get a successor
+		results := make([]error, 2)
+		var wg sync.WaitGroup
+		for i := range results {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_, results[i] = sessions.Refresh(first.RefreshToken)
+			}(i)
+		}
+		wg.Wait()
+
+		failed := 0
+		for _, err := range results {
+			if err != nil {
+				assertAppErrorCode(t, err, http.StatusUnauthorized)
+				failed++
+			}
+		}
+		assert.Equal(t, 1, failed)
+		assert.NotNil(t, onlySession(repo).RevokedAt)
+	})
+
+	t.Run("TestUnknownToken", func(t *testing.T) {
+		_, sessions, _, _ := newSession(t)
+
+		_, err := sessions.Refresh("unknown")
+		assertAppErrorCode(t, err, http.StatusUnauthorized)
+	})
+
+	t.Run("TestLogOutEndsRefresh", func(t *testing.T) {
+		_, sessions, _, first := newSession(t)
+
+		require.NoError(t, sessions.RevokeByRefreshToken(first.RefreshToken))
+
+		_, err := sessions.Refresh(first.RefreshToken)
+		assertAppErrorCode(t, err, http.StatusUnauthorized)
+	})
+
+	t.Run("TestSuspendedUser", func(t *testing.T) {
+		repo, sessions, user, first := newSession(t)
+		suspendedAt := time.Now()
+		user.SuspendedAt = &suspendedAt
+
+		_, err := sessions.Refresh(first.RefreshToken)
+		assertAppErrorCode(t, err, http.StatusForbidden)
+
+		active, err := sessions.IsSessionActive(onlySession(repo).ID)
+		require.NoError(t, err)
+		assert.False(t, active)
+	})
+}
@@ -0,0 +1,76 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"testing"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/infrastructure/stream"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamHub(t *testing.T) {
+	t.Run("TestDeliversOnlyToTheUser", func(t *testing.T) {
+		hub := stream.NewHub(4, 16)
+		alice, bob := uuid.New(), uuid.New()
+		client, _ := hub.Subscribe(alice, 0)
+
+		hub.SendToUsers([]uuid.UUID{bob}, "notification", map[string]int{"id": 1})
+		hub.SendToUsers([]uuid.UUID{alice}, "notification", map[string]int{"id": 2})
+
+		event := <-client.Events()
+		assert.Equal(t, "notification", event.Name)
+		assert.JSONEq(t, `{"id":2}`, string(event.Data))
+		assert.Len(t, client.Events(), 0)
+	})
+
+	t.Run("TestReplaysEventsAfterLastEventID", func(t *testing.T) {
+		hub := stream.NewHub(4, 16)
+		user := uuid.New()
+		first, _ := hub.Subscribe(user, 0)
+
+		hub.SendToUsers([]uuid.UUID{user}, "notification", 1)
+		hub.SendToUsers([]uuid.UUID{user}, "notification", 2)
+		hub.SendToUsers([]uuid.UUID{user}, "notification", 3)
+		seen := <-first.Events()
+		hub.Unsubscribe(first)
+
+		_, missed := hub.Subscribe(user, seen.ID)
+
+		if assert.Len(t, missed, 2) {
+			assert.Equal(t, "2", string(missed[0].Data))
+			assert.Equal(t, "3", string(missed[1].Data))
+		}
+	})
+
+	t.Run("TestHistoryIsBounded", func(t *testing.T) {
+		hub := stream.NewHub(4, 2)
+		user := uuid.New()
+
+		for i := 0; i < 5; i++ {
+			hub.SendToUsers([]uuid.UUID{user}, "notification", i)
+		}
+		_, missed := hub.Subscribe(user, 1)
+
+		if assert.Len(t, missed, 2) {
+			assert.Equal(t, "3", string(missed[0].Data))
+			assert.Equal(t, "4", string(missed[1].Data))
+		}
+	})
+
+	t.Run("TestDropsSlowClient", func(t *testing.T) {
+		hub := stream.NewHub(1, 16)
+		user := uuid.New()
+		client, _ := hub.Subscribe(user, 0)
+
+		hub.SendToUsers([]uuid.UUID{user}, "notification", 1)
+		hub.SendToUsers([]uuid.UUID{user}, "notification", 2)
+
+		select {
+		case <-client.Dropped():
+		default:
+			t.Fatal("expected the client to be dropped")
+		}
+	})
+}
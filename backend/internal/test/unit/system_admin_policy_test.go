@@ -0,0 +1,64 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"testing"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/pkg/authorization"
+	"github.com/casbin/casbin/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func newPolicyEnforcer(t *testing.T) *casbin.Enforcer {
+	enforcer, err := casbin.NewEnforcer("../../../pkg/authorization/rbac_model.conf")
+	if err != nil {
+		t.Fatalf("failed to create enforcer: %v", err)
+	}
+	if _, err := enforcer.AddPolicies(authorization.GetPermissionsList()); err != nil {
+		t.Fatalf("failed to add policies: %v", err)
+	}
+
+	return enforcer
+}
+
+func TestSystemAdminPolicy(t *testing.T) {
+	enforcer := newPolicyEnforcer(t)
+	_, _ = enforcer.AddGroupingPolicy("platform-admin", authorization.SystemAdmin, authorization.GlobalDomain)
+	_, _ = enforcer.AddGroupingPolicy("org-owner", "owner", "1")
+	_, _ = enforcer.AddNamedGroupingPolicy("g2", "legacy", "System Admin")
+
+	t.Run("system_admin passes global checks", func(t *testing.T) {
+		ok, err := enforcer.Enforce("platform-admin", authorization.GlobalDomain, "User", "suspend")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("system_admin is not granted unlisted global actions", func(t *testing.T) {
+		ok, err := enforcer.Enforce("platform-admin", authorization.GlobalDomain, "Organization", "delete")
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("system_admin gets nothing inside an organization", func(t *testing.T) {
+		ok, err := enforcer.Enforce("platform-admin", "1", "Organization", "read")
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("organization owner gets nothing globally", func(t *testing.T) {
+		ok, err := enforcer.Enforce("org-owner", authorization.GlobalDomain, "Organization", "read")
+		assert.NoError(t, err)
+		assert.False(t, ok)
+
+		ok, err = enforcer.Enforce("org-owner", "1", "Organization", "read")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("g2 System Admin still passes global checks", func(t *testing.T) {
+		ok, err := enforcer.Enforce("legacy", authorization.GlobalDomain, "Stats", "read")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+}
@@ -0,0 +1,94 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/errs"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/repository"
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/service"
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// checkViolationTicketRepository fails every write like postgres does for a negative quantity or price
+type checkViolationTicketRepository struct {
+	models.TicketAvailableRepository
+}
+
+func (checkViolationTicketRepository) Create(*models.TicketAvailable) error {
+	return &pgconn.PgError{Code: "23514", ConstraintName: "chk_ticket_availables_price"}
+}
+
+func (checkViolationTicketRepository) Update(*models.TicketAvailable) error {
+	return &pgconn.PgError{Code: "23514", ConstraintName: "chk_ticket_availables_quantity"}
+}
+
+func TestTicketAvailableCheckConstraint(t *testing.T) {
+	db := newSQLiteDB(t, &models.Organization{}, &models.Event{}, &models.ContactChannel{})
+	org := models.Organization{Name: "Org", Email: "org@example.com", Status: models.OrgStatusApproved}
+	require.NoError(t, db.Create(&org).Error)
+	event := models.Event{
+		Name:           "Paid",
+		StartDate:      utils.DateOnly{Time: time.Now()},
+		PriceType:      string(models.Paid),
+		OrganizationID: org.ID,
+	}
+	require.NoError(t, db.Create(&event).Error)
+
+	ticketService := service.NewTicketAvailableService(checkViolationTicketRepository{}, repository.NewEventRepository(db))
+	expected := errs.NewBadRequestError("quantity and price must not be negative")
+
+	t.Run("TestCreate", func(t *testing.T) {
+		err := ticketService.Create(org.ID, event.ID, &models.TicketAvailable{Price: -1})
+		assert.Equal(t, expected, err)
+	})
+
+	t.Run("TestUpdate", func(t *testing.T) {
+		err := ticketService.Update(org.ID, event.ID, &models.TicketAvailable{Quantity: -1})
+		assert.Equal(t, expected, err)
+	})
+}
+
+func TestTicketAvailableOrgScope(t *testing.T) {
+	db := newSQLiteDB(t, &models.Organization{}, &models.Event{}, &models.ContactChannel{}, &models.TicketAvailable{})
+	org := models.Organization{Name: "Org", Email: "org@example.com", Status: models.OrgStatusApproved}
+	other := models.Organization{Name: "Other", Email: "other@example.com", Status: models.OrgStatusApproved}
+	require.NoError(t, db.Create(&org).Error)
+	require.NoError(t, db.Create(&other).Error)
+	hiddenAt := time.Now()
+	event := models.Event{
+		Name:           "Hidden",
+		StartDate:      utils.DateOnly{Time: time.Now()},
+		PriceType:      string(models.Paid),
+		Status:         string(models.Published),
+		HiddenAt:       &hiddenAt,
+		OrganizationID: org.ID,
+	}
+	require.NoError(t, db.Create(&event).Error)
+	require.NoError(t, db.Create(&models.TicketAvailable{EventID: event.ID, Title: "General", Quantity: 10, Price: 100}).Error)
+
+	ticketService := service.NewTicketAvailableService(repository.NewTicketAvailableRepository(db), repository.NewEventRepository(db))
+
+	t.Run("TestOwnHiddenEvent", func(t *testing.T) {
+		tickets, err := ticketService.GetAllByEventIDwithOrgID(org.ID, event.ID)
+		require.NoError(t, err)
+		require.Len(t, tickets, 1)
+		assert.Equal(t, "General", tickets[0].Title)
+	})
+
+	t.Run("TestEventOfAnotherOrganization", func(t *testing.T) {
+		_, err := ticketService.GetAllByEventIDwithOrgID(other.ID, event.ID)
+		assert.Equal(t, errs.NewNotFoundError("event not found"), err)
+	})
+
+	t.Run("TestPublicHiddenEvent", func(t *testing.T) {
+		_, err := ticketService.GetAllByEventID(event.ID)
+		assert.Equal(t, errs.NewNotFoundError("event not found"), err)
+	})
+}
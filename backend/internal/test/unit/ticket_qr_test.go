@@ -0,0 +1,47 @@
+//go:build unit
+
+package unit_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTicketQR(t *testing.T) {
+	secret := "test-secret"
+
+	t.Run("TestVerifySignedPayload", func(t *testing.T) {
+		payload := utils.SignTicketQR(secret, 42)
+
+		eventID, err := utils.VerifyTicketQR(secret, payload)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint(42), eventID)
+	})
+
+	t.Run("TestRejectTamperedEventID", func(t *testing.T) {
+		payload := utils.SignTicketQR(secret, 42)
+		tampered := strings.Replace(payload, ".42.", ".43.", 1)
+
+		_, err := utils.VerifyTicketQR(secret, tampered)
+
+		assert.ErrorIs(t, err, utils.ErrInvalidTicketQR)
+	})
+
+	t.Run("TestRejectWrongSecret", func(t *testing.T) {
+		payload := utils.SignTicketQR(secret, 42)
+
+		_, err := utils.VerifyTicketQR("another-secret", payload)
+
+		assert.ErrorIs(t, err, utils.ErrInvalidTicketQR)
+	})
+
+	t.Run("TestRejectMalformedPayload", func(t *testing.T) {
+		_, err := utils.VerifyTicketQR(secret, "not-a-ticket")
+
+		assert.ErrorIs(t, err, utils.ErrInvalidTicketQR)
+	})
+}
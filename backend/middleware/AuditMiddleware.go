@@ -0,0 +1,165 @@
+package middleware
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/DAF-Bridge/asaiasa-Backend/internal/domain/models"
+	"github.com/DAF-Bridge/asaiasa-Backend/logs"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// AuditSnapshot loads the current state of what a request changes in the organization. It is
+// called before and after the handler; an error means there is no state, e.g. once deleted.
+type AuditSnapshot func(c *fiber.Ctx, orgID uint) (interface{}, error)
+
+// AuditMiddleware appends every successful mutation of the organization admin API to the audit
+// log. It runs after the permission check, see RBACMiddleware.WithAudit.
+type AuditMiddleware struct {
+	repo      models.AuditLogRepository
+	snapshots map[string]AuditSnapshot
+}
+
+// Fields that change on every write and would only add noise to the diff
+var ignoredAuditFields = map[string]bool{"updatedAt": true, "updated_at": true, "UpdatedAt": true}
+
+func NewAuditMiddleware(repo models.AuditLogRepository) *AuditMiddleware {
+	return &AuditMiddleware{repo: repo, snapshots: make(map[string]AuditSnapshot)}
+}
+
+// Snapshot registers how to load the state of resource so its changes can be diffed. Without one,
+// or when there is nothing to load, the request body is recorded as the new state.
+func (a *AuditMiddleware) Snapshot(resource string, snapshot AuditSnapshot) *AuditMiddleware {
+	a.snapshots[resource] = snapshot
+	return a
+}
+
+func (a *AuditMiddleware) record(c *fiber.Ctx, actor string, orgID uint, resource string, act string) error {
+	switch c.Method() {
+	case fiber.MethodGet, fiber.MethodHead, fiber.MethodOptions:
+		return c.Next()
+	}
+
+	actorID, err := uuid.Parse(actor)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid user_id uuid"})
+	}
+
+	snapshot := a.snapshots[resource]
+	before := takeSnapshot(c, snapshot, orgID)
+
+	if err := c.Next(); err != nil {
+		return err
+	}
+	if status := c.Response().StatusCode(); status < fiber.StatusOK || status >= fiber.StatusMultipleChoices {
+		return nil
+	}
+
+	after := takeSnapshot(c, snapshot, orgID)
+	if after == nil && c.Method() != fiber.MethodDelete {
+		after = requestState(c)
+	}
+
+	changes, err := json.Marshal(diffStates(before, after))
+	if err != nil {
+		logs.Error(err)
+		return nil
+	}
+
+	// The change is already made, a failure here is logged rather than failing the request
+	err = a.repo.Create(&models.AuditLog{
+		ActorID:        actorID,
+		OrganizationID: orgID,
+		Resource:       resource,
+		Action:         act,
+		Method:         c.Method(),
+		Path:           strings.Clone(c.Path()),
+		Changes:        string(changes),
+		IP:             c.IP(),
+	})
+	if err != nil {
+		logs.Error("Failed to write audit log: " + err.Error())
+	}
+
+	return nil
+}
+
+func takeSnapshot(c *fiber.Ctx, snapshot AuditSnapshot, orgID uint) map[string]interface{} {
+	if snapshot == nil {
+		return nil
+	}
+
+	state, err := snapshot(c, orgID)
+	if err != nil || state == nil {
+		return nil
+	}
+
+	return toState(state)
+}
+
+// requestState returns the fields sent with the request, from a JSON body or the values of a form
+func requestState(c *fiber.Ctx) map[string]interface{} {
+	if strings.HasPrefix(c.Get(fiber.HeaderContentType), fiber.MIMEMultipartForm) {
+		form, err := c.MultipartForm()
+		if err != nil {
+			return nil
+		}
+
+		state := make(map[string]interface{}, len(form.Value))
+		for key, values := range form.Value {
+			if len(values) == 1 {
+				state[key] = values[0]
+			} else {
+				state[key] = values
+			}
+		}
+		return state
+	}
+
+	var body interface{}
+	if err := json.Unmarshal(c.Body(), &body); err != nil {
+		return nil
+	}
+
+	return toState(body)
+}
+
+// toState flattens state into its top level JSON fields
+func toState(state interface{}) map[string]interface{} {
+	raw, err := json.Marshal(state)
+	if err != nil {
+		return nil
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		var value interface{}
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return nil
+		}
+		return map[string]interface{}{"value": value}
+	}
+
+	return fields
+}
+
+// diffStates returns the top level fields that differ between before and after
+func diffStates(before map[string]interface{}, after map[string]interface{}) map[string]map[string]interface{} {
+	changes := make(map[string]map[string]interface{})
+	for field, value := range before {
+		if ignoredAuditFields[field] || reflect.DeepEqual(value, after[field]) {
+			continue
+		}
+		changes[field] = map[string]interface{}{"before": value, "after": after[field]}
+	}
+	for field, value := range after {
+		if _, seen := before[field]; seen || ignoredAuditFields[field] {
+			continue
+		}
+		changes[field] = map[string]interface{}{"before": nil, "after": value}
+	}
+
+	return changes
+}
@@ -1,15 +1,24 @@
 package middleware
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/DAF-Bridge/asaiasa-Backend/logs"
 	"github.com/gofiber/fiber/v2"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
-func AuthMiddleware(jwtSecret string) fiber.Handler {
+// SessionValidator reports whether the session an access token was issued for is still active
+type SessionValidator interface {
+	IsSessionActive(sessionID uuid.UUID) (bool, error)
+}
+
+// AuthMiddleware rejects requests without a valid access token, or whose session was logged out
+// or revoked. A nil sessionValidator only verifies the signature and expiry of the token.
+func AuthMiddleware(jwtSecret string, sessionValidator SessionValidator) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		var tokenString string
 
@@ -33,26 +42,74 @@ func AuthMiddleware(jwtSecret string) fiber.Handler {
 			}
 		}
 
-		// Verify the token
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			// Validate the signing method
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				logs.Error(fmt.Sprintf("Unexpected signing method: %v", token.Header["alg"]))
-				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-			}
-			return []byte(jwtSecret), nil
-		})
-
-		if err != nil || !token.Valid {
-			logs.Error(fmt.Sprintf("Invalid token: %v", err))
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "Invalid token"})
+		claims, status, err := verifyToken(tokenString, jwtSecret, sessionValidator)
+		if err != nil {
+			return c.Status(status).JSON(fiber.Map{"error": err.Error()})
 		}
 
-		// Optionally, set the user information in the context
-		claims := token.Claims.(jwt.MapClaims)
 		c.Locals("user", claims)
 
 		// Proceed to the next middleware
 		return c.Next()
 	}
 }
+
+// OptionalAuthMiddleware is for public routes that show more to a logged-in user. A valid token
+// sets the user like AuthMiddleware does; a missing or invalid one lets the request through anonymously.
+func OptionalAuthMiddleware(jwtSecret string, sessionValidator SessionValidator) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		tokenString := strings.TrimPrefix(c.Get("Authorization"), "Bearer ")
+		if tokenString == "" {
+			tokenString = c.Cookies("authToken")
+		}
+		if tokenString == "" {
+			return c.Next()
+		}
+
+		if claims, _, err := verifyToken(tokenString, jwtSecret, sessionValidator); err == nil {
+			c.Locals("user", claims)
+		}
+
+		return c.Next()
+	}
+}
+
+// verifyToken checks the signature, expiry and session of an access token. On failure it
+// returns the status code and message to respond with.
+func verifyToken(tokenString string, jwtSecret string, sessionValidator SessionValidator) (jwt.MapClaims, int, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		// Validate the signing method
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			logs.Error(fmt.Sprintf("Unexpected signing method: %v", token.Header["alg"]))
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(jwtSecret), nil
+	})
+
+	if err != nil || !token.Valid {
+		logs.Error(fmt.Sprintf("Invalid token: %v", err))
+		return nil, fiber.StatusUnauthorized, errors.New("Invalid token")
+	}
+
+	claims := token.Claims.(jwt.MapClaims)
+
+	// Reject tokens whose session was logged out or revoked
+	if sessionValidator != nil {
+		sid, _ := claims["sid"].(string)
+		sessionID, err := uuid.Parse(sid)
+		if err != nil {
+			return nil, fiber.StatusUnauthorized, errors.New("Invalid token")
+		}
+
+		active, err := sessionValidator.IsSessionActive(sessionID)
+		if err != nil {
+			logs.Error(fmt.Sprintf("Failed to check session: %v", err))
+			return nil, fiber.StatusInternalServerError, errors.New("Internal Server Error")
+		}
+		if !active {
+			return nil, fiber.StatusUnauthorized, errors.New("Session has been revoked")
+		}
+	}
+
+	return claims, 0, nil
+}
@@ -3,6 +3,7 @@ package middleware
 import (
 	"fmt"
 
+	"github.com/DAF-Bridge/asaiasa-Backend/pkg/authorization"
 	"github.com/casbin/casbin/v2"
 	"github.com/gofiber/fiber/v2"
 	"github.com/golang-jwt/jwt/v5"
@@ -10,12 +11,18 @@ import (
 
 type RBACMiddleware struct {
 	enforcer casbin.IEnforcer
+	audit    *AuditMiddleware
 }
 
 func NewRBACMiddleware(enforcer casbin.IEnforcer) *RBACMiddleware {
 	return &RBACMiddleware{enforcer: enforcer}
 }
 
+// WithAudit returns a copy whose EnforceMiddleware also writes the mutations it lets through to the audit log
+func (r *RBACMiddleware) WithAudit(audit *AuditMiddleware) *RBACMiddleware {
+	return &RBACMiddleware{enforcer: r.enforcer, audit: audit}
+}
+
 func (r *RBACMiddleware) EnforceMiddleware(resources string, act string) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 
@@ -52,6 +59,33 @@ func (r *RBACMiddleware) EnforceMiddleware(resources string, act string) fiber.H
 			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "You are not authorized"})
 
 		}
+		if r.audit != nil {
+			return r.audit.record(c, sub, uint(orgID), resources, act)
+		}
+		return c.Next()
+	}
+}
+
+// EnforceGlobal checks a platform wide permission in the global domain; it needs no organization in the path
+func (r *RBACMiddleware) EnforceGlobal(resources string, act string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userData, ok := c.Locals("user").(jwt.MapClaims)
+		if !ok {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+		}
+
+		sub, ok := userData["user_id"].(string)
+		if !ok {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid user_id uuid"})
+		}
+
+		ok, err := r.enforcer.Enforce(sub, authorization.GlobalDomain, resources, act)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Error occurred when authorizing user"})
+		}
+		if !ok {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "You are not authorized"})
+		}
 		return c.Next()
 	}
 }
@@ -22,6 +22,10 @@ func main() {
 		log.Fatal("Database connection is not established.")
 	}
 
+	// Users who signed up before email verification existed are treated as verified, once, when the
+	// column is added. Checked first, as migrating the models referencing users adds it.
+	backfillEmailVerified := !initializers.DB.Migrator().HasColumn(&models.User{}, "email_verified_at")
+
 	// if err := initializers.DB.AutoMigrate(&models.Organization{}); err != nil {
 	// 	log.Fatal(err)
 	// }
@@ -49,6 +53,12 @@ func main() {
 	}
 
 	initializers.DB.AutoMigrate(&models.User{})
+	if backfillEmailVerified {
+		if err := initializers.DB.Exec(`UPDATE users SET email_verified_at = created_at
+			WHERE email_verified_at IS NULL`).Error; err != nil {
+			log.Fatal(err)
+		}
+	}
 	initializers.DB.AutoMigrate(&models.Organization{})
 	initializers.DB.AutoMigrate(&models.OrganizationContact{})
 	initializers.DB.AutoMigrate(&models.OrgOpenJob{})
@@ -58,6 +68,79 @@ func main() {
 	initializers.DB.AutoMigrate(&models.Profile{})
 	initializers.DB.AutoMigrate(&models.Experience{})
 	initializers.DB.AutoMigrate(&models.InviteToken{})
+	initializers.DB.AutoMigrate(&models.TicketAvailable{})
+	initializers.DB.AutoMigrate(&models.TicketPurchased{})
+	initializers.DB.AutoMigrate(&models.EventParticipant{})
+	initializers.DB.AutoMigrate(&models.UserSession{})
+	initializers.DB.AutoMigrate(&models.RefreshToken{})
+	initializers.DB.AutoMigrate(&models.UserToken{})
+	initializers.DB.AutoMigrate(&models.UserIdentity{})
+	initializers.DB.AutoMigrate(&models.ProfileVisibility{})
+	initializers.DB.AutoMigrate(&models.Application{})
+	initializers.DB.AutoMigrate(&models.ApplicationStatusChange{})
+	initializers.DB.AutoMigrate(&models.SavedSearch{})
+	initializers.DB.AutoMigrate(&models.SavedSearchHit{})
+	initializers.DB.AutoMigrate(&models.Bookmark{})
+	initializers.DB.AutoMigrate(&models.OrganizationFollow{})
+	initializers.DB.AutoMigrate(&models.Notification{})
+	// Organizations created before the review workflow were never reviewed, and only approved
+	// ones are listed; approve them once, when the review table is first created
+	if !initializers.DB.Migrator().HasTable(&models.OrganizationReview{}) {
+		if err := initializers.DB.Exec(`UPDATE organizations SET status = ?
+			WHERE status IS NULL OR status NOT IN (?, ?, ?)`,
+			models.OrgStatusApproved, models.OrgStatusRejected, models.OrgStatusChangesRequested, models.OrgStatusSuspended).Error; err != nil {
+			log.Fatal(err)
+		}
+	}
+	initializers.DB.AutoMigrate(&models.OrganizationReview{})
+	initializers.DB.AutoMigrate(&models.ModerationDecision{}, &models.Report{})
+	initializers.DB.AutoMigrate(&models.AuditLog{})
+	// The audit log is append-only, even for direct database access
+	auditLogTrigger := []string{
+		`CREATE OR REPLACE FUNCTION reject_audit_log_change() RETURNS trigger AS $$
+		BEGIN
+			RAISE EXCEPTION 'audit_logs is append-only';
+		END;
+		$$ LANGUAGE plpgsql`,
+		`DROP TRIGGER IF EXISTS audit_logs_append_only ON audit_logs`,
+		`CREATE TRIGGER audit_logs_append_only BEFORE UPDATE OR DELETE ON audit_logs
+		FOR EACH ROW EXECUTE FUNCTION reject_audit_log_change()`,
+	}
+	for _, statement := range auditLogTrigger {
+		if err := initializers.DB.Exec(statement).Error; err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// Treat everything published before publish times were recorded as published when it was created
+	if err := initializers.DB.Exec(`UPDATE events SET published_at = created_at
+		WHERE status = 'published' AND published_at IS NULL`).Error; err != nil {
+		log.Fatal(err)
+	}
+	if err := initializers.DB.Exec(`UPDATE org_open_jobs SET published_at = created_at
+		WHERE status = 'published' AND published_at IS NULL`).Error; err != nil {
+		log.Fatal(err)
+	}
+
+	// Move the single provider columns of users into user_identities, then drop them
+	if initializers.DB.Migrator().HasColumn(&models.User{}, "provider_id") {
+		if err := initializers.DB.Exec(`INSERT INTO user_identities (user_id, provider, provider_id, email, created_at)
+			SELECT id, provider::text, provider_id, email, created_at FROM users
+			WHERE provider <> 'local' AND provider_id <> '' AND deleted_at IS NULL
+			ON CONFLICT DO NOTHING`).Error; err != nil {
+			log.Fatal(err)
+		}
+		if err := initializers.DB.Exec(`UPDATE users SET email_verified_at = created_at
+			WHERE provider <> 'local' AND email_verified_at IS NULL`).Error; err != nil {
+			log.Fatal(err)
+		}
+		if err := initializers.DB.Migrator().DropColumn(&models.User{}, "provider_id"); err != nil {
+			log.Fatal(err)
+		}
+		if err := initializers.DB.Migrator().DropColumn(&models.User{}, "provider"); err != nil {
+			log.Fatal(err)
+		}
+	}
 
 	industries := []models.Industry{
 		{Industry: "Environment"},
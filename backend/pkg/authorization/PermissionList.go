@@ -1,5 +1,13 @@
 package authorization
 
+// SystemAdmin is the role behind the /sysadmin console. Unlike the organization roles it is
+// granted in GlobalDomain, e.g. "g, <user id>, system_admin, *", and only passes global checks.
+const SystemAdmin = "system_admin"
+
+// GlobalDomain is the casbin domain of platform wide grants such as SystemAdmin.
+// Organization domains are organization IDs, so it never collides with one.
+const GlobalDomain = "*"
+
 // Define a private map and a sync.Once instance for lazy initialization
 
 var allRole []string
@@ -7,12 +15,13 @@ var permissionsList [][]string
 
 // Read-only function to initialize permissionsList (called only once)
 func init() {
-	allRole = []string{"moderator", "owner", "system_admin"}
+	allRole = []string{"moderator", "owner", SystemAdmin}
 	moderatorPermissionsMap := map[string][]string{
 		"Event":               {"delete", "update", "create", "read"},
 		"Organization":        {"update", "read"},
 		"OrganizationContact": {"delete", "update", "create", "read"},
 		"OrganizationOpenJob": {"delete", "update", "create", "read"},
+		"Application":         {"update", "read"},
 		"Role":                {"read"},
 	}
 	moderatorPermissionsList := createCasbinPermissionsList("moderator", moderatorPermissionsMap)
@@ -21,10 +30,22 @@ func init() {
 	ownerPermissionsMap := map[string][]string{
 		"Organization": {"delete"},
 		"Role":         {"remove", "edit", "invite", "read"},
+		"AuditLog":     {"read"},
 	}
 	mergeMapSlice(ownerPermissionsMap, moderatorPermissionsMap)
 	ownerPermissionsList := createCasbinPermissionsList("owner", ownerPermissionsMap)
 	permissionsList = append(permissionsList, ownerPermissionsList...)
+
+	systemAdminPermissionsMap := map[string][]string{
+		"User":         {"read", "suspend", "logout", "grant"},
+		"Organization": {"read", "review"},
+		"Category":     {"delete", "update", "create", "read"},
+		"Industry":     {"delete", "update", "create", "read"},
+		"Stats":        {"read"},
+		"Report":       {"moderate", "read"},
+	}
+	systemAdminPermissionsList := createCasbinPermissionsList(SystemAdmin, systemAdminPermissionsMap)
+	permissionsList = append(permissionsList, systemAdminPermissionsList...)
 }
 
 func GetPermissionsList() [][]string {
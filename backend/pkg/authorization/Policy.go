@@ -0,0 +1,7 @@
+package authorization
+
+// Policy is a permission of a role: an action on a resource
+type Policy struct {
+	Resource string `json:"resource"`
+	Action   string `json:"action"`
+}
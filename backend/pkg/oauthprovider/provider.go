@@ -0,0 +1,108 @@
+package oauthprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"golang.org/x/oauth2"
+)
+
+// UserInfo is the profile of the signed in account, normalised across providers
+type UserInfo struct {
+	Provider      string
+	ProviderID    string
+	Name          string
+	Email         string
+	EmailVerified bool
+	AvatarURL     string
+}
+
+// FieldMapping names the user info fields in a provider's response. Nested fields
+// are addressed with dots, e.g. "picture.data.url".
+type FieldMapping struct {
+	ID            string
+	Name          string
+	Email         string
+	EmailVerified string
+	AvatarURL     string
+}
+
+// Provider is an OAuth 2.0 login provider described entirely by configuration
+type Provider struct {
+	Name        string
+	Config      *oauth2.Config
+	UserInfoURL string
+	Fields      FieldMapping
+	// TrustEmail marks providers that only ever return verified addresses
+	TrustEmail bool
+}
+
+// FetchUserInfo exchanges an authorization code and returns the profile it grants access to
+func (p *Provider) FetchUserInfo(ctx context.Context, code string) (*UserInfo, error) {
+	// Exchange the authorization code for an access token
+	token, err := p.Config.Exchange(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to exchange token: %v", err)
+	}
+
+	// Use the token to fetch user info
+	resp, err := p.Config.Client(ctx, token).Get(p.UserInfoURL)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to fetch user info: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Failed to fetch user info: %s returned %s", p.Name, resp.Status)
+	}
+
+	var raw map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("Failed to parse user info: %v", err)
+	}
+
+	info := &UserInfo{
+		Provider:   p.Name,
+		ProviderID: lookupString(raw, p.Fields.ID),
+		Name:       lookupString(raw, p.Fields.Name),
+		Email:      lookupString(raw, p.Fields.Email),
+		AvatarURL:  lookupString(raw, p.Fields.AvatarURL),
+	}
+	if info.ProviderID == "" {
+		return nil, fmt.Errorf("Failed to parse user info: %s did not return an account id", p.Name)
+	}
+
+	info.EmailVerified = info.Email != "" && (p.TrustEmail || lookupString(raw, p.Fields.EmailVerified) == "true")
+
+	return info, nil
+}
+
+func lookupString(raw map[string]interface{}, path string) string {
+	if path == "" {
+		return ""
+	}
+
+	var value interface{} = raw
+	for _, key := range strings.Split(path, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return ""
+		}
+		value = object[key]
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		// JSON numbers, e.g. numeric account ids
+		return fmt.Sprintf("%.0f", v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,96 @@
+package oauthprovider
+
+import (
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/facebook"
+	"golang.org/x/oauth2/google"
+)
+
+// Definition is everything about a provider that does not depend on the deployment
+type Definition struct {
+	Endpoint    oauth2.Endpoint
+	Scopes      []string
+	UserInfoURL string
+	Fields      FieldMapping
+	TrustEmail  bool
+}
+
+// Definitions are the providers that can be enabled. Adding a provider means adding an
+// entry here and setting <NAME>_CLIENT_ID and <NAME>_CLIENT_SECRET.
+var Definitions = map[string]Definition{
+	"google": {
+		Endpoint:    google.Endpoint,
+		Scopes:      []string{"email", "profile"},
+		UserInfoURL: "https://www.googleapis.com/oauth2/v2/userinfo",
+		Fields: FieldMapping{
+			ID:            "id",
+			Name:          "name",
+			Email:         "email",
+			EmailVerified: "verified_email",
+			AvatarURL:     "picture",
+		},
+	},
+	"facebook": {
+		Endpoint:    facebook.Endpoint,
+		Scopes:      []string{"email", "public_profile"},
+		UserInfoURL: "https://graph.facebook.com/me?fields=id,name,email,picture.type(large)",
+		Fields: FieldMapping{
+			ID:        "id",
+			Name:      "name",
+			Email:     "email",
+			AvatarURL: "picture.data.url",
+		},
+		// Facebook only returns addresses the user has confirmed
+		TrustEmail: true,
+	},
+}
+
+// Registry holds the enabled providers by name
+type Registry map[string]*Provider
+
+// Get returns the provider, or false when it is unknown or not configured
+func (r Registry) Get(name string) (*Provider, bool) {
+	provider, ok := r[name]
+	return provider, ok
+}
+
+// Credentials configures one provider for a deployment. The endpoint URLs are optional
+// overrides, used to point a provider at a local mock server.
+type Credentials struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	AuthURL      string
+	TokenURL     string
+	UserInfoURL  string
+}
+
+// New builds a provider from its definition and deployment credentials
+func New(name string, definition Definition, credentials Credentials) *Provider {
+	endpoint := definition.Endpoint
+	if credentials.AuthURL != "" {
+		endpoint.AuthURL = credentials.AuthURL
+	}
+	if credentials.TokenURL != "" {
+		endpoint.TokenURL = credentials.TokenURL
+	}
+
+	userInfoURL := definition.UserInfoURL
+	if credentials.UserInfoURL != "" {
+		userInfoURL = credentials.UserInfoURL
+	}
+
+	return &Provider{
+		Name: name,
+		Config: &oauth2.Config{
+			ClientID:     credentials.ClientID,
+			ClientSecret: credentials.ClientSecret,
+			RedirectURL:  credentials.RedirectURL,
+			Scopes:       definition.Scopes,
+			Endpoint:     endpoint,
+		},
+		UserInfoURL: userInfoURL,
+		Fields:      definition.Fields,
+		TrustEmail:  definition.TrustEmail,
+	}
+}
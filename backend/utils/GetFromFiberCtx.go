@@ -30,6 +30,21 @@ func GetUserIDFormFiberCtx(c *fiber.Ctx) (uuid.UUID, error) {
 
 }
 
+// GetSessionIDFormFiberCtx returns the session the access token of the request was issued for
+func GetSessionIDFormFiberCtx(c *fiber.Ctx) (uuid.UUID, error) {
+	userData, ok := c.Locals("user").(jwt.MapClaims)
+	if !ok {
+		return uuid.UUID{}, fmt.Errorf("unauthorized")
+	}
+
+	sid, _ := userData["sid"].(string)
+	sessionID, err := uuid.Parse(sid)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("invalid session id")
+	}
+	return sessionID, nil
+}
+
 func GetParamFormFiberCtx(c *fiber.Ctx, param, field string) (uint, error) {
 	// Access the organization
 	ID, err := c.ParamsInt(param)
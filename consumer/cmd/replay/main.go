@@ -0,0 +1,48 @@
+// Command replay re-drives the dead-letter topic of the CDC consumer: the messages that failed are
+// written back to their original topic for the consumer to process again.
+//
+//	go run ./cmd/replay -limit 100
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/DAF-Bridge/cdc-service/initializer"
+	"github.com/DAF-Bridge/cdc-service/internal/consumer"
+	"github.com/DAF-Bridge/cdc-service/pkg/logs"
+)
+
+func main() {
+	limit := flag.Int("limit", 0, "stop after replaying this many messages, 0 replays all of them")
+	idle := flag.Duration("idle", 10*time.Second, "stop once no message arrived for this long")
+	flag.Parse()
+
+	mode := os.Getenv("ENVIRONMENT")
+	if mode != "production" && (mode == "" || mode == "dev") {
+		initializer.LoadEnvVar()
+	}
+
+	topic := os.Getenv("KAFKA_TOPIC")
+	groupID := os.Getenv("KAFKA_DLQ_GROUP_ID")
+	if groupID == "" {
+		groupID = os.Getenv("KAFKA_GROUP_ID") + "-replay"
+	}
+
+	replayed, err := consumer.Replay(context.Background(), consumer.ReplayOptions{
+		Broker:  os.Getenv("KAFKA_BROKER"),
+		Topic:   consumer.DeadLetterTopic(topic, os.Getenv("KAFKA_DLQ_TOPIC")),
+		GroupID: groupID,
+		Limit:   *limit,
+		Idle:    *idle,
+	})
+	if err != nil {
+		log.Fatalf("Replay stopped after %d messages: %v", replayed, err)
+	}
+
+	logs.Info(fmt.Sprintf("Replayed %d dead-letter messages", replayed))
+}
@@ -44,6 +44,21 @@ func NewCannotBeProcessedError(message string) error {
 	}
 }
 
+// NewUnavailableError is for a dependency such as OpenSearch or the database failing in a way
+// that may go away on retry
+func NewUnavailableError(message string) error {
+	return AppError{
+		Code:    http.StatusServiceUnavailable,
+		Message: message,
+	}
+}
+
+// IsTransient reports whether err may go away on retry
+func IsTransient(err error) bool {
+	var appErr AppError
+	return errors.As(err, &appErr) && appErr.Code == http.StatusServiceUnavailable
+}
+
 func NewConflictError(message string) error {
 	return AppError{
 		Code:    http.StatusConflict,
@@ -51,6 +66,12 @@ func NewConflictError(message string) error {
 	}
 }
 
+// IsConflict reports whether err is a conflict, such as a write older than the stored version
+func IsConflict(err error) bool {
+	var appErr AppError
+	return errors.As(err, &appErr) && appErr.Code == http.StatusConflict
+}
+
 func NewBadRequestError(message string) error {
 	return AppError{
 		Code:    http.StatusBadRequest,
@@ -1,7 +1,11 @@
 package app
 
 import (
+	"context"
+	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/DAF-Bridge/cdc-service/initializer"
@@ -31,9 +35,38 @@ func Start() {
 	opensearchSrv := service.NewOpenSearchService(opensearchRepo, jobRepo, eventRepo, orgRepo)
 	cdcConsumer := consumer.NewOpenSearchCDC(opensearchRepo, *opensearchSrv)
 
+	kafkaConsumer := consumer.NewKafkaConsumer(cdcConsumer)
+
+	// SIGTERM from Kubernetes stops the consumer after the messages in flight
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	port := os.Getenv("APP_PORT")
+	if port == "" {
+		port = "8000"
+	}
+	healthServer := newHealthServer(kafkaConsumer, initializer.ESClient)
+	go func() {
+		if err := healthServer.Listen(":" + port); err != nil {
+			logs.Error(fmt.Sprintf("Health server stopped: %v", err))
+		}
+	}()
+
 	logs.Info("Starting the application")
-	consumer.StartKafka(cdcConsumer)
-	logs.Info("Application started successfully")
+	err := kafkaConsumer.Run(ctx)
+	if err != nil {
+		logs.Error(fmt.Sprintf("Consumer stopped: %v", err))
+	}
 
-	time.Sleep(10 * time.Minute)
+	logs.Info("Shutting down")
+	if closeErr := kafkaConsumer.Close(); closeErr != nil {
+		logs.Error(fmt.Sprintf("Error closing the consumer: %v", closeErr))
+	}
+	if shutdownErr := healthServer.ShutdownWithTimeout(5 * time.Second); shutdownErr != nil {
+		logs.Error(fmt.Sprintf("Error shutting down the health server: %v", shutdownErr))
+	}
+
+	if err != nil {
+		os.Exit(1)
+	}
 }
@@ -0,0 +1,80 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/DAF-Bridge/cdc-service/internal/consumer"
+	"github.com/gofiber/fiber/v2"
+	"github.com/opensearch-project/opensearch-go"
+)
+
+const healthCheckTimeout = 3 * time.Second
+
+// newHealthServer serves the probes of the consumer: /healthz for liveness, /readyz for Kafka
+// and OpenSearch being reachable and /lag for how far the consumer group is behind.
+func newHealthServer(kafkaConsumer *consumer.KafkaConsumer, es *opensearch.Client) *fiber.App {
+	server := fiber.New(fiber.Config{DisableStartupMessage: true})
+
+	server.Get("/healthz", func(c *fiber.Ctx) error {
+		return c.Status(fiber.StatusOK).JSON(fiber.Map{"status": "ok"})
+	})
+
+	server.Get("/readyz", func(c *fiber.Ctx) error {
+		ctx, cancel := context.WithTimeout(c.Context(), healthCheckTimeout)
+		defer cancel()
+
+		checks := fiber.Map{"kafka": "ok", "opensearch": "ok"}
+		ready := true
+		if _, err := kafkaConsumer.Lag(ctx); err != nil {
+			checks["kafka"] = err.Error()
+			ready = false
+		}
+		if err := pingOpenSearch(ctx, es); err != nil {
+			checks["opensearch"] = err.Error()
+			ready = false
+		}
+
+		if !ready {
+			return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"status": "unavailable", "checks": checks})
+		}
+		return c.Status(fiber.StatusOK).JSON(fiber.Map{"status": "ok", "checks": checks})
+	})
+
+	server.Get("/lag", func(c *fiber.Ctx) error {
+		ctx, cancel := context.WithTimeout(c.Context(), healthCheckTimeout)
+		defer cancel()
+
+		partitions, err := kafkaConsumer.Lag(ctx)
+		if err != nil {
+			return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"error": err.Error()})
+		}
+
+		var total int64
+		for _, partition := range partitions {
+			total += partition.Lag
+		}
+
+		return c.Status(fiber.StatusOK).JSON(fiber.Map{
+			"consumer":   kafkaConsumer.Stats(),
+			"totalLag":   total,
+			"partitions": partitions,
+		})
+	})
+
+	return server
+}
+
+func pingOpenSearch(ctx context.Context, es *opensearch.Client) error {
+	res, err := es.Ping(es.Ping.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("opensearch responded %s", res.Status())
+	}
+	return nil
+}
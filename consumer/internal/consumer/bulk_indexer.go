@@ -0,0 +1,205 @@
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/DAF-Bridge/cdc-service/errs"
+	"github.com/DAF-Bridge/cdc-service/internal/models"
+	"github.com/DAF-Bridge/cdc-service/internal/repository"
+	"github.com/DAF-Bridge/cdc-service/pkg/logs"
+	"github.com/segmentio/kafka-go"
+)
+
+// inflightMessage is a message between the fetch and its commit
+type inflightMessage struct {
+	message kafka.Message
+	event   *models.CDCEvent
+	// err is set when the message cannot even be decoded
+	err error
+
+	// pending counts the operations of the message not written yet, failure keeps the first error
+	pending  atomic.Int32
+	mu       sync.Mutex
+	failure  error
+	attempts int
+}
+
+// operationDone records the result of one operation and reports whether it was the last one
+func (m *inflightMessage) operationDone(err error, attempts int) bool {
+	m.mu.Lock()
+	if err != nil && m.failure == nil {
+		m.failure = err
+	}
+	if attempts > m.attempts {
+		m.attempts = attempts
+	}
+	m.mu.Unlock()
+
+	return m.pending.Add(-1) == 0
+}
+
+type bulkItem struct {
+	operation models.DocumentOperation
+	message   *inflightMessage
+}
+
+// bulkIndexer buffers the operations of the workers and writes them with one _bulk request once
+// maxActions are buffered or every flushInterval. Adding blocks during a flush, which holds the
+// workers back while OpenSearch is slow.
+type bulkIndexer struct {
+	repo          repository.OpenSearchRepository
+	policy        RetryPolicy
+	maxActions    int
+	flushInterval time.Duration
+	// complete is called once all the operations of a message are written, or one failed for good
+	complete func(m *inflightMessage, err error, attempts int)
+	// stale counts the writes rejected because the document has a newer version
+	stale *atomic.Int64
+
+	mu      sync.Mutex
+	pending []bulkItem
+}
+
+func (b *bulkIndexer) add(ctx context.Context, item bulkItem) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.pending = append(b.pending, item)
+	if len(b.pending) >= b.maxActions {
+		b.flushLocked(ctx)
+	}
+}
+
+// run flushes every flushInterval until stop is closed
+func (b *bulkIndexer) run(ctx context.Context, stop <-chan struct{}) {
+	ticker := time.NewTicker(b.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			b.flush(ctx)
+		}
+	}
+}
+
+func (b *bulkIndexer) flush(ctx context.Context) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.flushLocked(ctx)
+}
+
+// flushLocked writes the buffer in order: the document writes between two updates-by-query go in
+// one _bulk request, so an update never runs before the writes buffered ahead of it. The operations
+// that fail with a transient error are retried. Once ctx is cancelled they are given up without
+// completing their message, which is then not committed.
+func (b *bulkIndexer) flushLocked(ctx context.Context) {
+	items := b.pending
+	b.pending = nil
+
+	for len(items) > 0 {
+		writes := 0
+		for writes < len(items) && items[writes].operation.Update == nil {
+			writes++
+		}
+
+		var finished bool
+		if writes == 0 {
+			finished = b.update(ctx, items[0])
+			items = items[1:]
+		} else {
+			finished = b.write(ctx, items[:writes])
+			items = items[writes:]
+		}
+		if !finished {
+			logs.Info(fmt.Sprintf("Shutting down during the retries of bulk operations, the messages of %d more will be consumed again", len(items)))
+			return
+		}
+	}
+}
+
+// write sends items in a _bulk request, it reports false when ctx was cancelled during the retries
+func (b *bulkIndexer) write(ctx context.Context, items []bulkItem) bool {
+	for attempt := 1; ; attempt++ {
+		operations := make([]models.DocumentOperation, len(items))
+		for i, item := range items {
+			operations[i] = item.operation
+		}
+		results := b.repo.Bulk(operations)
+
+		retryable := attempt < b.policy.MaxAttempts
+		// A failed write is outdated by a later write of the same document that went through,
+		// retrying it would bring the old version back
+		written := make(map[string]bool)
+		retry := make([]bulkItem, 0)
+		for i := len(items) - 1; i >= 0; i-- {
+			key := items[i].operation.Key()
+			switch err := results[i]; {
+			case err == nil || written[key]:
+				written[key] = true
+				b.done(items[i], nil, attempt)
+			case errs.IsConflict(err):
+				// A redelivered or reordered change, the document already holds newer data
+				logs.Warn(fmt.Sprintf("Skipping stale write of document %s at version %d from message %d/%d",
+					key, items[i].operation.Version, items[i].message.message.Partition, items[i].message.message.Offset))
+				b.stale.Add(1)
+				written[key] = true
+				b.done(items[i], nil, attempt)
+			case errs.IsTransient(err) && retryable:
+				retry = append([]bulkItem{items[i]}, retry...)
+			default:
+				b.done(items[i], err, attempt)
+			}
+		}
+		if len(retry) == 0 {
+			return true
+		}
+
+		wait := b.policy.backoff(attempt)
+		logs.Warn(fmt.Sprintf("Retrying %d of %d bulk operations in %s after attempt %d", len(retry), len(items), wait, attempt))
+		if !sleep(ctx, wait) {
+			return false
+		}
+		items = retry
+	}
+}
+
+// update runs an update-by-query, it reports false when ctx was cancelled during the retries
+func (b *bulkIndexer) update(ctx context.Context, item bulkItem) bool {
+	for attempt := 1; ; attempt++ {
+		err := b.repo.UpdateByQuery(item.operation.Index, *item.operation.Update)
+		if err == nil || !errs.IsTransient(err) || attempt >= b.policy.MaxAttempts {
+			b.done(item, err, attempt)
+			return true
+		}
+
+		wait := b.policy.backoff(attempt)
+		logs.Warn(fmt.Sprintf("Retrying the update of %s in %s after attempt %d failed: %v", item.operation.Key(), wait, attempt, err))
+		if !sleep(ctx, wait) {
+			return false
+		}
+	}
+}
+
+// sleep waits for d, it reports false when ctx is cancelled first
+func sleep(ctx context.Context, d time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
+
+func (b *bulkIndexer) done(item bulkItem, err error, attempts int) {
+	if item.message.operationDone(err, attempts) {
+		b.complete(item.message, item.message.failure, item.message.attempts)
+	}
+}
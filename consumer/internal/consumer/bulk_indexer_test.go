@@ -0,0 +1,210 @@
+package consumer
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DAF-Bridge/cdc-service/errs"
+	"github.com/DAF-Bridge/cdc-service/internal/models"
+	"github.com/DAF-Bridge/cdc-service/internal/repository"
+	"github.com/segmentio/kafka-go"
+)
+
+// fakeOpenSearch answers every Bulk call with the next of results, the operations all succeed once
+// results run out
+type fakeOpenSearch struct {
+	repository.OpenSearchRepository
+	results [][]error
+	bulks   [][]models.DocumentOperation
+	calls   []string
+}
+
+func (f *fakeOpenSearch) Bulk(operations []models.DocumentOperation) []error {
+	f.bulks = append(f.bulks, operations)
+	for _, operation := range operations {
+		f.calls = append(f.calls, operation.Key())
+	}
+
+	results := make([]error, len(operations))
+	if len(f.results) > 0 {
+		copy(results, f.results[0])
+		f.results = f.results[1:]
+	}
+	return results
+}
+
+func (f *fakeOpenSearch) UpdateByQuery(index string, update models.DocumentUpdate) error {
+	f.calls = append(f.calls, index+"/("+update.Name+")")
+	return nil
+}
+
+type completion struct {
+	err      error
+	attempts int
+}
+
+func newTestIndexer(repo *fakeOpenSearch, maxAttempts int) (*bulkIndexer, map[*inflightMessage]completion) {
+	completed := make(map[*inflightMessage]completion)
+	return &bulkIndexer{
+		repo:          repo,
+		policy:        RetryPolicy{MaxAttempts: maxAttempts, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+		maxActions:    100,
+		flushInterval: time.Hour,
+		complete: func(m *inflightMessage, err error, attempts int) {
+			completed[m] = completion{err: err, attempts: attempts}
+		},
+		stale: new(atomic.Int64),
+	}, completed
+}
+
+func newTestMessage(offset int64, operations int) *inflightMessage {
+	message := &inflightMessage{message: kafka.Message{Topic: "cdc", Offset: offset}}
+	message.pending.Store(int32(operations))
+	return message
+}
+
+func eventWrite(id uint, version int64) models.DocumentOperation {
+	return models.DocumentOperation{Index: "events", ID: id, Document: map[string]interface{}{}, Version: version}
+}
+
+func TestBulkIndexerPartialFailure(t *testing.T) {
+	repo := &fakeOpenSearch{results: [][]error{
+		{nil, errs.NewUnavailableError("shard unavailable"), errs.NewConflictError("version conflict")},
+	}}
+	indexer, completed := newTestIndexer(repo, 3)
+
+	first := newTestMessage(1, 2)
+	second := newTestMessage(2, 1)
+	ctx := context.Background()
+	indexer.add(ctx, bulkItem{operation: eventWrite(1, 1), message: first})
+	indexer.add(ctx, bulkItem{operation: eventWrite(2, 1), message: first})
+	indexer.add(ctx, bulkItem{operation: eventWrite(3, 1), message: second})
+	indexer.flush(ctx)
+
+	if len(repo.bulks) != 2 {
+		t.Fatalf("bulk requests = %d, want 2", len(repo.bulks))
+	}
+	if retried := repo.bulks[1]; len(retried) != 1 || retried[0].ID != 2 {
+		t.Errorf("retried operations = %v, want only event 2", retried)
+	}
+	if got := completed[first]; got.err != nil || got.attempts != 2 {
+		t.Errorf("first message completed with %v after %d attempts, want no error after 2", got.err, got.attempts)
+	}
+	if got, ok := completed[second]; !ok || got.err != nil || got.attempts != 1 {
+		t.Errorf("second message completed %t with %v after %d attempts, want no error after 1", ok, got.err, got.attempts)
+	}
+	if stale := indexer.stale.Load(); stale != 1 {
+		t.Errorf("stale writes = %d, want 1", stale)
+	}
+}
+
+func TestBulkIndexerRetriesExhausted(t *testing.T) {
+	unavailable := errs.NewUnavailableError("cluster unavailable")
+	repo := &fakeOpenSearch{results: [][]error{{unavailable}, {unavailable}, {unavailable}, {unavailable}}}
+	indexer, completed := newTestIndexer(repo, 3)
+
+	message := newTestMessage(1, 1)
+	indexer.add(context.Background(), bulkItem{operation: eventWrite(1, 1), message: message})
+	indexer.flush(context.Background())
+
+	if len(repo.bulks) != 3 {
+		t.Errorf("bulk requests = %d, want 3", len(repo.bulks))
+	}
+	if got := completed[message]; !errs.IsTransient(got.err) || got.attempts != 3 {
+		t.Errorf("message completed with %v after %d attempts, want the unavailable error after 3", got.err, got.attempts)
+	}
+}
+
+func TestBulkIndexerPermanentFailure(t *testing.T) {
+	repo := &fakeOpenSearch{results: [][]error{{errs.NewBadRequestError("mapper_parsing_exception"), nil}}}
+	indexer, completed := newTestIndexer(repo, 3)
+
+	failed := newTestMessage(1, 1)
+	written := newTestMessage(2, 1)
+	indexer.add(context.Background(), bulkItem{operation: eventWrite(1, 1), message: failed})
+	indexer.add(context.Background(), bulkItem{operation: eventWrite(2, 1), message: written})
+	indexer.flush(context.Background())
+
+	if len(repo.bulks) != 1 {
+		t.Errorf("bulk requests = %d, want 1 as the error is not transient", len(repo.bulks))
+	}
+	if got := completed[failed]; got.err == nil {
+		t.Error("failed message completed without error")
+	}
+	if got, ok := completed[written]; !ok || got.err != nil {
+		t.Errorf("written message completed %t with %v, want no error", ok, got.err)
+	}
+	if stale := indexer.stale.Load(); stale != 0 {
+		t.Errorf("stale writes = %d, want 0", stale)
+	}
+}
+
+func TestBulkIndexerSkipsOutdatedRetry(t *testing.T) {
+	// The first write of event 1 fails, the later one in the same request goes through
+	repo := &fakeOpenSearch{results: [][]error{{errs.NewUnavailableError("shard unavailable"), nil}}}
+	indexer, completed := newTestIndexer(repo, 3)
+
+	older := newTestMessage(1, 1)
+	newer := newTestMessage(2, 1)
+	indexer.add(context.Background(), bulkItem{operation: eventWrite(1, 1), message: older})
+	indexer.add(context.Background(), bulkItem{operation: eventWrite(1, 2), message: newer})
+	indexer.flush(context.Background())
+
+	if len(repo.bulks) != 1 {
+		t.Errorf("bulk requests = %d, want 1 as the failed write is outdated", len(repo.bulks))
+	}
+	for _, message := range []*inflightMessage{older, newer} {
+		if got, ok := completed[message]; !ok || got.err != nil {
+			t.Errorf("message %d completed %t with %v, want no error", message.message.Offset, ok, got.err)
+		}
+	}
+}
+
+func TestBulkIndexerCancelledDuringRetry(t *testing.T) {
+	repo := &fakeOpenSearch{results: [][]error{{errs.NewUnavailableError("cluster unavailable")}}}
+	indexer, completed := newTestIndexer(repo, 3)
+	indexer.policy.InitialBackoff = time.Hour
+	indexer.policy.MaxBackoff = time.Hour
+
+	ctx, cancel := context.WithCancel(context.Background())
+	message := newTestMessage(1, 1)
+	indexer.add(ctx, bulkItem{operation: eventWrite(1, 1), message: message})
+	cancel()
+	indexer.flush(ctx)
+
+	if _, ok := completed[message]; ok {
+		t.Error("message completed although its write was given up")
+	}
+}
+
+func TestBulkIndexerKeepsUpdatesInOrder(t *testing.T) {
+	repo := &fakeOpenSearch{}
+	indexer, completed := newTestIndexer(repo, 3)
+
+	message := newTestMessage(1, 4)
+	update := models.DocumentOperation{Index: "events", Update: &models.DocumentUpdate{Name: "organization 1"}}
+	ctx := context.Background()
+	indexer.add(ctx, bulkItem{operation: eventWrite(1, 1), message: message})
+	indexer.add(ctx, bulkItem{operation: update, message: message})
+	indexer.add(ctx, bulkItem{operation: eventWrite(2, 1), message: message})
+	indexer.add(ctx, bulkItem{operation: eventWrite(3, 1), message: message})
+	indexer.flush(ctx)
+
+	want := []string{"events/1", "events/(organization 1)", "events/2", "events/3"}
+	if len(repo.calls) != len(want) {
+		t.Fatalf("calls = %v, want %v", repo.calls, want)
+	}
+	for i := range want {
+		if repo.calls[i] != want[i] {
+			t.Fatalf("calls = %v, want %v", repo.calls, want)
+		}
+	}
+	if len(repo.bulks) != 2 {
+		t.Errorf("bulk requests = %d, want 2 around the update", len(repo.bulks))
+	}
+	if got, ok := completed[message]; !ok || got.err != nil {
+		t.Errorf("message completed %t with %v, want no error", ok, got.err)
+	}
+}
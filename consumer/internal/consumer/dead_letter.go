@@ -0,0 +1,174 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DAF-Bridge/cdc-service/errs"
+	"github.com/DAF-Bridge/cdc-service/pkg/logs"
+	"github.com/segmentio/kafka-go"
+)
+
+// Headers added to a message sent to the dead-letter topic
+const (
+	HeaderDLQError             = "x-dlq-error"
+	HeaderDLQErrorKind         = "x-dlq-error-kind"
+	HeaderDLQAttempts          = "x-dlq-attempts"
+	HeaderDLQOriginalTopic     = "x-dlq-original-topic"
+	HeaderDLQOriginalPartition = "x-dlq-original-partition"
+	HeaderDLQOriginalOffset    = "x-dlq-original-offset"
+	HeaderDLQFailedAt          = "x-dlq-failed-at"
+	// HeaderDLQReplays counts how many times the message was re-driven from the dead-letter topic
+	HeaderDLQReplays = "x-dlq-replays"
+)
+
+const (
+	errorKindTransient = "transient"
+	errorKindPoison    = "poison"
+)
+
+// DeadLetterTopic returns the topic failed messages are sent to, KAFKA_DLQ_TOPIC or "<topic>.dlq"
+func DeadLetterTopic(topic string, configured string) string {
+	if configured != "" {
+		return configured
+	}
+	return topic + ".dlq"
+}
+
+type DeadLetterQueue struct {
+	writer *kafka.Writer
+}
+
+func NewDeadLetterQueue(broker string, topic string) *DeadLetterQueue {
+	return &DeadLetterQueue{
+		writer: &kafka.Writer{
+			Addr:                   kafka.TCP(broker),
+			Topic:                  topic,
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll,
+			AllowAutoTopicCreation: true,
+		},
+	}
+}
+
+// Send writes message to the dead-letter topic with the error that made it fail. The original key,
+// value and headers are kept so the message can be replayed as is.
+func (q *DeadLetterQueue) Send(ctx context.Context, message kafka.Message, cause error, attempts int) error {
+	kind := errorKindPoison
+	if errs.IsTransient(cause) {
+		kind = errorKindTransient
+	}
+
+	headers := withoutDLQHeaders(message.Headers, HeaderDLQReplays)
+	headers = append(headers,
+		kafka.Header{Key: HeaderDLQError, Value: []byte(cause.Error())},
+		kafka.Header{Key: HeaderDLQErrorKind, Value: []byte(kind)},
+		kafka.Header{Key: HeaderDLQAttempts, Value: []byte(strconv.Itoa(attempts))},
+		kafka.Header{Key: HeaderDLQOriginalTopic, Value: []byte(message.Topic)},
+		kafka.Header{Key: HeaderDLQOriginalPartition, Value: []byte(strconv.Itoa(message.Partition))},
+		kafka.Header{Key: HeaderDLQOriginalOffset, Value: []byte(strconv.FormatInt(message.Offset, 10))},
+		kafka.Header{Key: HeaderDLQFailedAt, Value: []byte(time.Now().UTC().Format(time.RFC3339))},
+	)
+
+	return q.writer.WriteMessages(ctx, kafka.Message{Key: message.Key, Value: message.Value, Headers: headers})
+}
+
+func (q *DeadLetterQueue) Close() error {
+	return q.writer.Close()
+}
+
+// ReplayOptions controls a run of Replay
+type ReplayOptions struct {
+	Broker  string
+	Topic   string
+	GroupID string
+	// Limit stops the replay after that many messages, 0 replays everything
+	Limit int
+	// Idle stops the replay once no message arrived for that long
+	Idle time.Duration
+}
+
+// Replay re-drives the dead-letter topic: every message is written back to the topic it came from,
+// without the error headers, and committed on the dead-letter topic once written. The consumer then
+// processes it again, and sends it back here if it still fails. It returns the number of messages
+// replayed.
+func Replay(ctx context.Context, opts ReplayOptions) (int, error) {
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     []string{opts.Broker},
+		GroupID:     opts.GroupID,
+		Topic:       opts.Topic,
+		MaxBytes:    10e6, // 10MB
+		StartOffset: kafka.FirstOffset,
+	})
+	defer reader.Close()
+
+	writer := &kafka.Writer{
+		Addr:         kafka.TCP(opts.Broker),
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+	}
+	defer writer.Close()
+
+	replayed := 0
+	for opts.Limit == 0 || replayed < opts.Limit {
+		fetchCtx, cancel := context.WithTimeout(ctx, opts.Idle)
+		message, err := reader.FetchMessage(fetchCtx)
+		cancel()
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+				logs.Info(fmt.Sprintf("No dead-letter message for %s, stopping the replay", opts.Idle))
+				return replayed, nil
+			}
+			return replayed, err
+		}
+
+		originalTopic := headerValue(message.Headers, HeaderDLQOriginalTopic)
+		if originalTopic == "" {
+			return replayed, fmt.Errorf("message %d/%d has no %s header", message.Partition, message.Offset, HeaderDLQOriginalTopic)
+		}
+
+		replays, _ := strconv.Atoi(headerValue(message.Headers, HeaderDLQReplays))
+		headers := withoutDLQHeaders(message.Headers)
+		headers = append(headers, kafka.Header{Key: HeaderDLQReplays, Value: []byte(strconv.Itoa(replays + 1))})
+
+		err = writer.WriteMessages(ctx, kafka.Message{Topic: originalTopic, Key: message.Key, Value: message.Value, Headers: headers})
+		if err != nil {
+			return replayed, fmt.Errorf("error replaying message %d/%d to %s: %w", message.Partition, message.Offset, originalTopic, err)
+		}
+		if err := reader.CommitMessages(ctx, message); err != nil {
+			return replayed, fmt.Errorf("error committing replayed message %d/%d: %w", message.Partition, message.Offset, err)
+		}
+
+		logs.Info(fmt.Sprintf("Replayed dead-letter message %d/%d to %s (%s)",
+			message.Partition, message.Offset, originalTopic, headerValue(message.Headers, HeaderDLQError)))
+		replayed++
+	}
+
+	return replayed, nil
+}
+
+func headerValue(headers []kafka.Header, key string) string {
+	for _, header := range headers {
+		if header.Key == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+// withoutDLQHeaders drops the dead-letter headers, except the ones listed in keep
+func withoutDLQHeaders(headers []kafka.Header, keep ...string) []kafka.Header {
+	kept := make([]kafka.Header, 0, len(headers))
+	for _, header := range headers {
+		if strings.HasPrefix(header.Key, "x-dlq-") && !slices.Contains(keep, header.Key) {
+			continue
+		}
+		kept = append(kept, header)
+	}
+	return kept
+}
@@ -3,10 +3,17 @@ package consumer
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
+	"hash/fnv"
 	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/DAF-Bridge/cdc-service/errs"
 	"github.com/DAF-Bridge/cdc-service/internal/models"
 	"github.com/DAF-Bridge/cdc-service/internal/repository"
 	"github.com/DAF-Bridge/cdc-service/internal/service"
@@ -26,46 +33,97 @@ func NewOpenSearchCDC(repo repository.OpenSearchRepository, service service.Open
 	}
 }
 
-func (opn *OpenSearchCDC) ConsumeMessage(message kafka.Message) error {
-	var event models.CDCEvent
-	if event.Payload.Op == "r" {
-		logs.Info(fmt.Sprintf("Ignoring read operation (r) for event: %v", event))
-		return nil
-	}
-
-	if event.Payload.Op == "d" {
-		err := opn.service.ProcessEvent(event)
-		if err != nil {
-			logs.Error(fmt.Sprintf("Error processing event: %v", err))
-			return fmt.Errorf("error processing event: %v", err)
-		}
+// decodeMessage returns the change carried by message, nil when there is nothing to process
+func decodeMessage(message kafka.Message) (*models.CDCEvent, error) {
+	// Debezium follows every delete with a tombstone, which has nothing to process
+	if len(message.Value) == 0 {
+		return nil, nil
 	}
 
+	var event models.CDCEvent
 	if err := json.Unmarshal(message.Value, &event); err != nil {
-		return fmt.Errorf("error unmarshalling event: %v", err)
+		return nil, errs.NewCannotBeProcessedError(fmt.Sprintf("error unmarshalling event: %v", err))
 	}
 	// Process only Create, Update, Delete events
 	switch event.Payload.Op {
 	case "c", "u", "d":
-		// Delegate processing to the service layer
-		err := opn.service.ProcessEvent(event)
-		if err != nil {
-			logs.Error(fmt.Sprintf("Error processing event: %v", err))
-			return fmt.Errorf("error processing event: %v", err)
-		}
+		return &event, nil
 	default:
 		logs.Info(fmt.Sprintf("Ignoring read operation (r) for event: %v", event))
 		// Ignore "r" (read) operations
-		return nil
+		return nil, nil
 	}
+}
 
-	return nil
+// orderingKey is the row a message changes. Messages with the same key go to the same worker, so
+// the changes of one document are applied in order.
+func orderingKey(m *inflightMessage) string {
+	if m.event != nil {
+		if key := service.OrderingKey(*m.event); key != "" {
+			return key
+		}
+	}
+	return string(m.message.Key)
 }
 
-func StartKafka(cdcConsumer *OpenSearchCDC) {
+const (
+	defaultWorkers           = 8
+	defaultBulkActions       = 500
+	defaultBulkFlushInterval = time.Second
+	workerQueueSize          = 64
+	commitInterval           = time.Second
+)
+
+// KafkaConsumer consumes the CDC topic. Messages are spread over a pool of workers by the row they
+// change and their documents written to OpenSearch in _bulk requests. Offsets are committed by hand
+// once every message before them is written, or in the dead-letter topic, so a crash never skips
+// a message.
+type KafkaConsumer struct {
+	cdc      *OpenSearchCDC
+	broker   string
+	topic    string
+	groupID  string
+	dlqTopic string
+	reader   *kafka.Reader
+	dlq      *DeadLetterQueue
+
+	workers       int
+	bulkActions   int
+	flushInterval time.Duration
+	offsets       *offsetTracker
+	// fail stops Run with an error
+	fail context.CancelCauseFunc
+
+	processed    atomic.Int64
+	deadLettered atomic.Int64
+	stale        atomic.Int64
+	lastCommit   atomic.Int64
+}
+
+// ConsumerStats is what the consumer did since it started
+type ConsumerStats struct {
+	Topic        string `json:"topic"`
+	GroupID      string `json:"groupId"`
+	Processed    int64  `json:"processed"`
+	DeadLettered int64  `json:"deadLettered"`
+	// Stale counts the document writes skipped because OpenSearch held a newer version
+	Stale        int64      `json:"stale"`
+	LastCommitAt *time.Time `json:"lastCommitAt"`
+}
+
+// PartitionLag is how far the consumer group is behind on one partition of the topic
+type PartitionLag struct {
+	Partition       int   `json:"partition"`
+	CommittedOffset int64 `json:"committedOffset"`
+	HighWatermark   int64 `json:"highWatermark"`
+	Lag             int64 `json:"lag"`
+}
+
+func NewKafkaConsumer(cdcConsumer *OpenSearchCDC) *KafkaConsumer {
 	broker := os.Getenv("KAFKA_BROKER")
 	topic := os.Getenv("KAFKA_TOPIC")
 	groupID := string(os.Getenv("KAFKA_GROUP_ID"))
+	dlqTopic := DeadLetterTopic(topic, os.Getenv("KAFKA_DLQ_TOPIC"))
 
 	config := kafka.ReaderConfig{
 		Brokers:  []string{broker},
@@ -74,19 +132,281 @@ func StartKafka(cdcConsumer *OpenSearchCDC) {
 		MaxBytes: 10e6, // 10MB
 	}
 
-	reader := kafka.NewReader(config)
+	return &KafkaConsumer{
+		cdc:           cdcConsumer,
+		broker:        broker,
+		topic:         topic,
+		groupID:       groupID,
+		dlqTopic:      dlqTopic,
+		reader:        kafka.NewReader(config),
+		dlq:           NewDeadLetterQueue(broker, dlqTopic),
+		workers:       envInt("CONSUMER_WORKERS", defaultWorkers),
+		bulkActions:   envInt("OPENSEARCH_BULK_ACTIONS", defaultBulkActions),
+		flushInterval: envDuration("OPENSEARCH_BULK_FLUSH_INTERVAL", defaultBulkFlushInterval),
+		offsets:       newOffsetTracker(topic),
+	}
+}
+
+// Run consumes until ctx is cancelled. The messages being converted at that point and the buffered
+// documents are still written and committed; the messages still queued, or waiting for a retry, are
+// left uncommitted and consumed again on the next start.
+func (k *KafkaConsumer) Run(ctx context.Context) error {
+	ctx, k.fail = context.WithCancelCause(ctx)
+	defer k.fail(nil)
+
+	indexer := &bulkIndexer{
+		repo:          k.cdc.repo,
+		policy:        DefaultRetryPolicy,
+		maxActions:    k.bulkActions,
+		flushInterval: k.flushInterval,
+		complete:      k.complete,
+		stale:         &k.stale,
+	}
+
+	stop := make(chan struct{})
+	var background sync.WaitGroup
+	background.Add(2)
+	go func() {
+		defer background.Done()
+		indexer.run(ctx, stop)
+	}()
+	go func() {
+		defer background.Done()
+		k.commitEvery(commitInterval, stop)
+	}()
+
+	queues := make([]chan *inflightMessage, k.workers)
+	var workers sync.WaitGroup
+	for i := range queues {
+		queues[i] = make(chan *inflightMessage, workerQueueSize)
+		workers.Add(1)
+		go func(queue <-chan *inflightMessage) {
+			defer workers.Done()
+			k.work(ctx, queue, indexer)
+		}(queues[i])
+	}
 
+	logs.Info(fmt.Sprintf("Consuming %s with %d workers", k.topic, k.workers))
+	k.dispatch(ctx, queues)
+
+	for _, queue := range queues {
+		close(queue)
+	}
+	workers.Wait()
+	indexer.flush(ctx)
+	close(stop)
+	background.Wait()
+
+	// Committing must not be cut short by the shutdown
+	if err := k.commit(context.WithoutCancel(ctx)); err != nil {
+		k.fail(err)
+	}
+
+	if err := context.Cause(ctx); !errors.Is(err, context.Canceled) {
+		return err
+	}
+	return nil
+}
+
+// dispatch fetches messages and queues each on the worker of its row until ctx is cancelled
+func (k *KafkaConsumer) dispatch(ctx context.Context, queues []chan *inflightMessage) {
 	for {
-		msg, err := reader.ReadMessage(context.Background())
+		msg, err := k.reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				k.fail(fmt.Errorf("error fetching message: %w", err))
+			}
+			return
+		}
+
+		logs.Debug(fmt.Sprintf("Message received from %s/%d at offset %d", msg.Topic, msg.Partition, msg.Offset))
+
+		m := &inflightMessage{message: msg}
+		m.event, m.err = decodeMessage(msg)
+		k.offsets.add(msg.Partition, msg.Offset)
+
+		hash := fnv.New32a()
+		hash.Write([]byte(orderingKey(m)))
+		select {
+		case queues[hash.Sum32()%uint32(len(queues))] <- m:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// work converts the messages of queue into document operations for the indexer, one at a time
+func (k *KafkaConsumer) work(ctx context.Context, queue <-chan *inflightMessage, indexer *bulkIndexer) {
+	for m := range queue {
+		// The rest of the queue is consumed again on the next start
+		if ctx.Err() != nil {
+			continue
+		}
+		if m.err != nil || m.event == nil {
+			k.complete(m, m.err, 1)
+			continue
+		}
 
+		operations, attempts, err := k.cdc.operationsWithRetry(ctx, m, DefaultRetryPolicy)
 		if err != nil {
-			log.Fatal(err)
+			if ctx.Err() != nil {
+				logs.Info(fmt.Sprintf("Shutting down during the retries of message %d/%d, it will be consumed again", m.message.Partition, m.message.Offset))
+				continue
+			}
+			k.complete(m, err, attempts)
+			continue
+		}
+		if len(operations) == 0 {
+			k.complete(m, nil, attempts)
+			continue
 		}
 
-		logs.Info(fmt.Sprintf("Message received: %v", string(msg.Value)))
+		m.pending.Store(int32(len(operations)))
+		for _, operation := range operations {
+			indexer.add(ctx, bulkItem{operation: operation, message: m})
+		}
+	}
+}
 
-		if err := cdcConsumer.ConsumeMessage(msg); err != nil {
-			logs.Error(fmt.Sprintf("Error consuming message: %v", err))
+// complete finishes a message, sending it to the dead-letter topic when it failed, so it can be
+// committed
+func (k *KafkaConsumer) complete(m *inflightMessage, err error, attempts int) {
+	if err != nil {
+		logs.Error(fmt.Sprintf("Sending message %d/%d to %s after %d attempts: %v", m.message.Partition, m.message.Offset, k.dlqTopic, attempts, err))
+		// Without the dead-letter copy the message must not be committed, so it is consumed again on restart
+		if sendErr := k.dlq.Send(context.Background(), m.message, err, attempts); sendErr != nil {
+			k.fail(fmt.Errorf("error sending message to the dead-letter topic: %w", sendErr))
+			return
+		}
+		k.deadLettered.Add(1)
+	} else {
+		k.processed.Add(1)
+	}
+
+	k.offsets.done(m.message.Partition, m.message.Offset)
+}
+
+func (k *KafkaConsumer) commitEvery(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if err := k.commit(context.Background()); err != nil {
+				k.fail(err)
+				return
+			}
 		}
 	}
 }
+
+// commit commits every partition up to its last message with all the messages before it done
+func (k *KafkaConsumer) commit(ctx context.Context) error {
+	messages := k.offsets.committable()
+	if len(messages) == 0 {
+		return nil
+	}
+
+	if err := k.reader.CommitMessages(ctx, messages...); err != nil {
+		return fmt.Errorf("error committing messages: %w", err)
+	}
+	k.lastCommit.Store(time.Now().Unix())
+	return nil
+}
+
+func envInt(key string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || value <= 0 {
+		return fallback
+	}
+	return value
+}
+
+func envDuration(key string, fallback time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(key))
+	if err != nil || value <= 0 {
+		return fallback
+	}
+	return value
+}
+
+// Close leaves the consumer group and flushes the dead-letter writer
+func (k *KafkaConsumer) Close() error {
+	return errors.Join(k.reader.Close(), k.dlq.Close())
+}
+
+func (k *KafkaConsumer) Stats() ConsumerStats {
+	stats := ConsumerStats{
+		Topic:        k.topic,
+		GroupID:      k.groupID,
+		Processed:    k.processed.Load(),
+		DeadLettered: k.deadLettered.Load(),
+		Stale:        k.stale.Load(),
+	}
+	if lastCommit := k.lastCommit.Load(); lastCommit > 0 {
+		at := time.Unix(lastCommit, 0).UTC()
+		stats.LastCommitAt = &at
+	}
+	return stats
+}
+
+// Lag asks the broker for the committed offset of the consumer group and the high watermark of
+// every partition of the topic. It also tells whether Kafka is reachable.
+func (k *KafkaConsumer) Lag(ctx context.Context) ([]PartitionLag, error) {
+	client := &kafka.Client{Addr: kafka.TCP(k.broker)}
+
+	metadata, err := client.Metadata(ctx, &kafka.MetadataRequest{Topics: []string{k.topic}})
+	if err != nil {
+		return nil, err
+	}
+	if len(metadata.Topics) == 0 {
+		return nil, fmt.Errorf("topic %s not found", k.topic)
+	}
+	if metadata.Topics[0].Error != nil {
+		return nil, metadata.Topics[0].Error
+	}
+
+	partitions := make([]int, 0, len(metadata.Topics[0].Partitions))
+	bounds := make([]kafka.OffsetRequest, 0, 2*len(metadata.Topics[0].Partitions))
+	for _, partition := range metadata.Topics[0].Partitions {
+		partitions = append(partitions, partition.ID)
+		bounds = append(bounds, kafka.FirstOffsetOf(partition.ID), kafka.LastOffsetOf(partition.ID))
+	}
+
+	committed, err := client.OffsetFetch(ctx, &kafka.OffsetFetchRequest{GroupID: k.groupID, Topics: map[string][]int{k.topic: partitions}})
+	if err != nil {
+		return nil, err
+	}
+	if committed.Error != nil {
+		return nil, committed.Error
+	}
+
+	offsets, err := client.ListOffsets(ctx, &kafka.ListOffsetsRequest{Topics: map[string][]kafka.OffsetRequest{k.topic: bounds}})
+	if err != nil {
+		return nil, err
+	}
+
+	highWatermarks := make(map[int]kafka.PartitionOffsets)
+	for _, partition := range offsets.Topics[k.topic] {
+		highWatermarks[partition.Partition] = partition
+	}
+
+	lags := make([]PartitionLag, 0, len(partitions))
+	for _, partition := range committed.Topics[k.topic] {
+		high := highWatermarks[partition.Partition]
+		lag := PartitionLag{Partition: partition.Partition, CommittedOffset: partition.CommittedOffset, HighWatermark: high.LastOffset}
+		// Nothing committed yet, everything from the first offset is still to be consumed
+		if partition.CommittedOffset < 0 {
+			lag.Lag = high.LastOffset - high.FirstOffset
+		} else {
+			lag.Lag = high.LastOffset - partition.CommittedOffset
+		}
+		lags = append(lags, lag)
+	}
+
+	sort.Slice(lags, func(i, j int) bool { return lags[i].Partition < lags[j].Partition })
+	return lags, nil
+}
@@ -0,0 +1,77 @@
+package consumer
+
+import (
+	"sync"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// offsetTracker follows the messages in flight of every partition. Workers finish them out of
+// order, so a partition is only committed up to the last message before the oldest unfinished one.
+type offsetTracker struct {
+	topic      string
+	mu         sync.Mutex
+	partitions map[int]*partitionOffsets
+}
+
+type partitionOffsets struct {
+	// inflight is in fetch order, finished counts the offsets of it that are done. An offset can be
+	// fetched twice when a rebalance rewinds the partition.
+	inflight []int64
+	finished map[int64]int
+	// committable is the offset to commit next, -1 once committed
+	committable int64
+}
+
+func newOffsetTracker(topic string) *offsetTracker {
+	return &offsetTracker{topic: topic, partitions: make(map[int]*partitionOffsets)}
+}
+
+func (t *offsetTracker) add(partition int, offset int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	offsets, ok := t.partitions[partition]
+	if !ok {
+		offsets = &partitionOffsets{finished: make(map[int64]int), committable: -1}
+		t.partitions[partition] = offsets
+	}
+	offsets.inflight = append(offsets.inflight, offset)
+}
+
+// done marks a message as processed or dead-lettered
+func (t *offsetTracker) done(partition int, offset int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	offsets, ok := t.partitions[partition]
+	if !ok {
+		return
+	}
+	offsets.finished[offset]++
+	for len(offsets.inflight) > 0 && offsets.finished[offsets.inflight[0]] > 0 {
+		oldest := offsets.inflight[0]
+		offsets.committable = oldest
+		if offsets.finished[oldest]--; offsets.finished[oldest] == 0 {
+			delete(offsets.finished, oldest)
+		}
+		offsets.inflight = offsets.inflight[1:]
+	}
+}
+
+// committable returns, for every partition that moved since the last call, the last message that
+// can be committed
+func (t *offsetTracker) committable() []kafka.Message {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var messages []kafka.Message
+	for partition, offsets := range t.partitions {
+		if offsets.committable < 0 {
+			continue
+		}
+		messages = append(messages, kafka.Message{Topic: t.topic, Partition: partition, Offset: offsets.committable})
+		offsets.committable = -1
+	}
+	return messages
+}
@@ -0,0 +1,110 @@
+package consumer
+
+import (
+	"sort"
+	"testing"
+)
+
+// committed returns the offset committable per partition, and clears it like a commit would
+func committed(t *offsetTracker) map[int]int64 {
+	offsets := make(map[int]int64)
+	for _, message := range t.committable() {
+		offsets[message.Partition] = message.Offset
+	}
+	return offsets
+}
+
+func TestOffsetTrackerOutOfOrder(t *testing.T) {
+	tracker := newOffsetTracker("cdc")
+	for offset := int64(10); offset < 15; offset++ {
+		tracker.add(0, offset)
+	}
+
+	tracker.done(0, 12)
+	tracker.done(0, 14)
+	if got := committed(tracker); len(got) != 0 {
+		t.Fatalf("committable before the oldest message is done = %v, want none", got)
+	}
+
+	tracker.done(0, 10)
+	if got := committed(tracker); got[0] != 10 {
+		t.Fatalf("committable = %v, want offset 10", got)
+	}
+	if got := committed(tracker); len(got) != 0 {
+		t.Fatalf("committable after commit = %v, want none", got)
+	}
+
+	tracker.done(0, 11)
+	if got := committed(tracker); got[0] != 12 {
+		t.Fatalf("committable = %v, want offset 12 as 12 was done before", got)
+	}
+
+	tracker.done(0, 13)
+	if got := committed(tracker); got[0] != 14 {
+		t.Fatalf("committable = %v, want offset 14", got)
+	}
+}
+
+func TestOffsetTrackerPartitions(t *testing.T) {
+	tracker := newOffsetTracker("cdc")
+	tracker.add(0, 1)
+	tracker.add(1, 1)
+	tracker.add(1, 2)
+	tracker.add(2, 7)
+
+	tracker.done(1, 2)
+	tracker.done(0, 1)
+	tracker.done(2, 7)
+	tracker.done(3, 1) // never added
+
+	messages := tracker.committable()
+	sort.Slice(messages, func(i, j int) bool { return messages[i].Partition < messages[j].Partition })
+	if len(messages) != 2 {
+		t.Fatalf("committable = %v, want partitions 0 and 2", messages)
+	}
+	for i, want := range []struct {
+		partition int
+		offset    int64
+	}{{0, 1}, {2, 7}} {
+		if messages[i].Topic != "cdc" || messages[i].Partition != want.partition || messages[i].Offset != want.offset {
+			t.Errorf("committable[%d] = %s/%d/%d, want cdc/%d/%d", i,
+				messages[i].Topic, messages[i].Partition, messages[i].Offset, want.partition, want.offset)
+		}
+	}
+}
+
+func TestOffsetTrackerRewind(t *testing.T) {
+	tracker := newOffsetTracker("cdc")
+	tracker.add(0, 5)
+	tracker.add(0, 6)
+	// A rebalance rewinds the partition, 5 and 6 are fetched again while the first copies run
+	tracker.add(0, 5)
+	tracker.add(0, 6)
+
+	tracker.done(0, 5)
+	if got := committed(tracker); got[0] != 5 {
+		t.Fatalf("committable = %v, want offset 5", got)
+	}
+
+	// The first done of 6 moves past the first copy only, the second copy of 5 is still running
+	tracker.done(0, 6)
+	if got := committed(tracker); got[0] != 6 {
+		t.Fatalf("committable = %v, want offset 6", got)
+	}
+
+	tracker.done(0, 6)
+	if got := committed(tracker); len(got) != 0 {
+		t.Fatalf("committable while the second 5 runs = %v, want none", got)
+	}
+
+	tracker.done(0, 5)
+	if got := committed(tracker); got[0] != 6 {
+		t.Fatalf("committable = %v, want offset 6", got)
+	}
+	if inflight := tracker.partitions[0].inflight; len(inflight) != 0 {
+		t.Errorf("inflight = %v, want empty", inflight)
+	}
+	if finished := tracker.partitions[0].finished; len(finished) != 0 {
+		t.Errorf("finished = %v, want empty", finished)
+	}
+}
@@ -0,0 +1,71 @@
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/DAF-Bridge/cdc-service/errs"
+	"github.com/DAF-Bridge/cdc-service/internal/models"
+	"github.com/DAF-Bridge/cdc-service/pkg/logs"
+)
+
+// RetryPolicy bounds how long a message is retried before it is sent to the dead-letter topic.
+// Only transient errors are retried, see errs.IsTransient.
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    5,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     30 * time.Second,
+}
+
+// backoff returns the wait before the given retry, doubling from InitialBackoff up to MaxBackoff
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	wait := p.InitialBackoff
+	for i := 1; i < retry && wait < p.MaxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	return wait
+}
+
+// operationsWithRetry converts the event of message into its document operations, retrying
+// transient errors such as the database being unavailable with exponential backoff. It returns the
+// last error and the number of attempts made.
+func (opn *OpenSearchCDC) operationsWithRetry(ctx context.Context, message *inflightMessage, policy RetryPolicy) ([]models.DocumentOperation, int, error) {
+	for attempt := 1; ; attempt++ {
+		operations, err := opn.safeDocumentOperations(*message.event)
+		if err == nil || !errs.IsTransient(err) || attempt >= policy.MaxAttempts {
+			return operations, attempt, err
+		}
+
+		wait := policy.backoff(attempt)
+		logs.Warn(fmt.Sprintf("Retrying message %s/%d/%d in %s after attempt %d failed: %v",
+			message.message.Topic, message.message.Partition, message.message.Offset, wait, attempt, err))
+
+		select {
+		case <-ctx.Done():
+			return nil, attempt, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// safeDocumentOperations turns a panic while converting, e.g. on a payload missing a field, into an
+// error so the message ends up in the dead-letter topic instead of crashing the consumer
+func (opn *OpenSearchCDC) safeDocumentOperations(event models.CDCEvent) (operations []models.DocumentOperation, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errs.NewCannotBeProcessedError(fmt.Sprintf("panic while consuming message: %v", r))
+		}
+	}()
+
+	return opn.service.DocumentOperations(event)
+}
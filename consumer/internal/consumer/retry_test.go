@@ -0,0 +1,49 @@
+package consumer
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 10, InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	for _, test := range []struct {
+		retry int
+		want  time.Duration
+	}{
+		{0, 100 * time.Millisecond},
+		{1, 100 * time.Millisecond},
+		{2, 200 * time.Millisecond},
+		{3, 400 * time.Millisecond},
+		{4, 800 * time.Millisecond},
+		{5, time.Second},
+		{100, time.Second},
+	} {
+		if got := policy.backoff(test.retry); got != test.want {
+			t.Errorf("backoff(%d) = %s, want %s", test.retry, got, test.want)
+		}
+	}
+}
+
+func TestRetryPolicyBackoffInitialAboveMax(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: time.Minute, MaxBackoff: time.Second}
+	if got := policy.backoff(1); got != time.Second {
+		t.Errorf("backoff(1) = %s, want %s", got, time.Second)
+	}
+}
+
+func TestDefaultRetryPolicyBounds(t *testing.T) {
+	var total time.Duration
+	for retry := 1; retry < DefaultRetryPolicy.MaxAttempts; retry++ {
+		wait := DefaultRetryPolicy.backoff(retry)
+		if wait < DefaultRetryPolicy.InitialBackoff || wait > DefaultRetryPolicy.MaxBackoff {
+			t.Errorf("backoff(%d) = %s, want between %s and %s", retry, wait,
+				DefaultRetryPolicy.InitialBackoff, DefaultRetryPolicy.MaxBackoff)
+		}
+		total += wait
+	}
+	if limit := time.Duration(DefaultRetryPolicy.MaxAttempts) * DefaultRetryPolicy.MaxBackoff; total > limit {
+		t.Errorf("total backoff = %s, want at most %s", total, limit)
+	}
+}
@@ -15,4 +15,14 @@ type CDCPayload struct {
 
 type CDCSource struct {
 	Table string `json:"table"` // The name of the table where the change occurred
+	LSN   int64  `json:"lsn"`   // Position of the change in the Postgres write-ahead log
+}
+
+// Version orders the changes of a row, it is the external version of the search documents written
+// for the change. The log position is used when the connector sends it, the timestamp otherwise.
+func (e CDCEvent) Version() int64 {
+	if e.Payload.Source.LSN > 0 {
+		return e.Payload.Source.LSN
+	}
+	return e.Payload.TsMs
 }
\ No newline at end of file
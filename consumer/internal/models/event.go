@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	"github.com/DAF-Bridge/cdc-service/utils"
 	"gorm.io/gorm"
 )
@@ -74,6 +76,8 @@ type Event struct {
 	PriceType       string            `gorm:"type:varchar(50)" db:"price_type" json:"priceType"`
 	RegisterLink    string            `gorm:"type:varchar(255)" db:"register_link"`
 	Status          string            `gorm:"type:varchar(50)" db:"status"`
+	PublishedAt     *time.Time        `gorm:"index" db:"published_at"`
+	HiddenAt        *time.Time        `gorm:"index" db:"hidden_at"` // Set when a system admin hides the event after a report
 	ContactChannels []ContactChannel  `gorm:"foreignKey:EventID;references:ID" db:"contact_channels"`
 	Categories      []Category        `gorm:"many2many:category_event;"`
 	OrganizationID  uint              `gorm:"not null" db:"organization_id"`
@@ -1,6 +1,10 @@
 package models
 
-import "github.com/DAF-Bridge/cdc-service/internal/dto"
+import (
+	"fmt"
+
+	"github.com/DAF-Bridge/cdc-service/internal/dto"
+)
 
 type SearchQuery struct {
 	Page       int    `json:"page" form:"page"`               // The page number
@@ -54,6 +58,8 @@ type OrganizationShortDocument struct {
 	ID     uint   `json:"id"`
 	Name   string `json:"name"`
 	PicUrl string `json:"picUrl"`
+	// Version is when the organization was last updated, in microseconds
+	Version int64 `json:"version"`
 }
 
 type PrerequisiteDocument struct {
@@ -79,17 +85,51 @@ type JobDocument struct {
 }
 
 type OrganizationDocument struct {
-	ID          uint    `json:"id"`
-	Name        string  `json:"name"`
-	PicUrl      string  `json:"picUrl"`
-	Description string  `json:"description"`
-	Latitude    float64 `json:"latitude"`
-	Longitude   float64 `json:"longitude"`
-	Province    string  `json:"province"`
-	Country     string  `json:"country"`
-	Email       string  `json:"email"`
-	Phone       string  `json:"phone"`
-	UpdateAt    string  `json:"updatedAt"`
+	ID          uint     `json:"id"`
+	Name        string   `json:"name"`
+	PicUrl      string   `json:"picUrl"`
+	HeadLine    string   `json:"headline"`
+	Description string   `json:"description"`
+	Latitude    float64  `json:"latitude"`
+	Longitude   float64  `json:"longitude"`
+	Province    string   `json:"province"`
+	Country     string   `json:"country"`
+	Email       string   `json:"email"`
+	Phone       string   `json:"phone"`
+	Industries  []string `json:"industries"`
+	Status      string   `json:"status"`
+	UpdateAt    string   `json:"updatedAt"`
+}
+
+// DocumentOperation is a write to one document of the search indexes, batched into a _bulk request
+type DocumentOperation struct {
+	Index string
+	ID    uint
+	// Document is indexed as the new version of the document, nil deletes it
+	Document interface{}
+	// Version is the external version of the write, OpenSearch rejects it when the document has a
+	// newer one. 0 writes without a version.
+	Version int64
+	// Update, when set, runs on every document of Index it matches instead of writing document ID
+	Update *DocumentUpdate
+}
+
+// DocumentUpdate is an update-by-query, for data copied into many documents such as the name of
+// their organization
+type DocumentUpdate struct {
+	// Name describes the documents updated, for the logs
+	Name   string
+	Query  map[string]interface{}
+	Script string
+	Params map[string]interface{}
+}
+
+// Key identifies the document the operation writes
+func (o DocumentOperation) Key() string {
+	if o.Update != nil {
+		return fmt.Sprintf("%s/(%s)", o.Index, o.Update.Name)
+	}
+	return fmt.Sprintf("%s/%d", o.Index, o.ID)
 }
 
 type SearchEventResponse struct {
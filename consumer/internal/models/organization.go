@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 )
 
@@ -52,6 +54,13 @@ const (
 	JobStatusArchived  JobStatus = "archived"
 )
 
+const (
+	// OrgStatusApproved is set by review, only the events and jobs of approved organizations are searchable
+	OrgStatusApproved = "approved"
+	// OrgStatusSuspended is set by moderation, a suspended organization is taken out of search
+	OrgStatusSuspended = "suspended"
+)
+
 //---------------------------------------------------------------------------
 // Models
 //---------------------------------------------------------------------------
@@ -71,6 +80,7 @@ type Organization struct {
 	Country              string                `gorm:"type:varchar(255)" db:"country"`
 	Latitude             float64               `gorm:"type:decimal(10,8)" db:"latitude"`  // Geographic latitude (stored as string for precision)
 	Longitude            float64               `gorm:"type:decimal(11,8)" db:"longitude"` // Geographic longitude (stored as string for precision)
+	Status               string                `gorm:"type:varchar(50);default:'pending'" db:"status"`
 	OrganizationContacts []OrganizationContact `gorm:"foreignKey:OrganizationID;constraint:onUpdate:CASCADE,onDelete:CASCADE;"`
 	OrgOpenJobs          []OrgOpenJob          `gorm:"foreignKey:OrganizationID;constraint:onUpdate:CASCADE,onDelete:CASCADE;"`
 	Industries           []*Industry           `gorm:"many2many:organization_industry;"`
@@ -113,6 +123,8 @@ type OrgOpenJob struct {
 	Quantity       int            `json:"quantity" example:"1"`
 	RegisterLink   string         `gorm:"type:varchar(255)" db:"register_link"`
 	Status         string         `gorm:"type:varchar(50);default:'draft'" json:"status" example:"draft"`
+	PublishedAt    *time.Time     `gorm:"index" json:"publishedAt"`
+	HiddenAt       *time.Time     `gorm:"index" json:"hiddenAt"`                                          // Set when a system admin hides the job after a report
 	Prerequisites  []Prerequisite `gorm:"foreignKey:JobID;constraint:onUpdate:CASCADE,onDelete:CASCADE;"` // Job prerequisites
 	Categories     []Category     `gorm:"many2many:category_job;"`
 }
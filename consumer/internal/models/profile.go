@@ -32,8 +32,8 @@ type Experience struct {
 	UUID        uuid.UUID      `gorm:"type:uuid;default:uuid_generate_v4();primarykey" db:"uuid"`
 	ProfileID   uint           `gorm:"type:uint;not null" db:"profile_id"`
 	Currently   bool           `gorm:"default:false;not null" db:"currently"`
-	StartDate   time.Time      `gorm:"time:DATE" db:"start_date"`
-	EndDate     time.Time      `gorm:"time:DATE" db:"end_date"`
+	StartDate   time.Time      `gorm:"type:date;not null" db:"start_date"`
+	EndDate     *time.Time     `gorm:"type:date" db:"end_date"` // nil while Currently is true
 	Title       string         `gorm:"type:varchar(255);not null" db:"title"`
 	PicUrl      string         `gorm:"type:varchar(255)" db:"pic_url"`
 	Description string         `gorm:"type:text" db:"description"`
@@ -32,15 +32,13 @@ const (
 //---------------------------------------------------------------------------
 
 type User struct {
-	ID         uuid.UUID      `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" db:"id"`
-	Name       string         `gorm:"type:varchar(255);not null" db:"name"`
-	PicUrl     string         `gorm:"type:text;" db:"pic_url"`
-	Email      string         `gorm:"type:varchar(255);not null" db:"email"`
-	Password   *string        `gorm:"type:varchar(255)" db:"-"` // Hashed password for traditional login
-	Role       Role           `gorm:"type:Role;default:'User'" db:"role"`
-	Provider   Provider       `gorm:"type:Provider;not null" db:"provider"` // e.g., "google"
-	ProviderID string         `gorm:"type:varchar(255);not null" db:"provider_id"`
-	CreatedAt  time.Time      `gorm:"autoCreateTime" db:"created_at"`
-	UpdatedAt  time.Time      `gorm:"autoUpdateTime" db:"updated_at"`
-	DeletedAt  gorm.DeletedAt `gorm:"index" db:"deleted_at"`
+	ID        uuid.UUID      `gorm:"type:uuid;default:uuid_generate_v4();primaryKey" db:"id"`
+	Name      string         `gorm:"type:varchar(255);not null" db:"name"`
+	PicUrl    string         `gorm:"type:text;" db:"pic_url"`
+	Email     string         `gorm:"type:varchar(255);not null" db:"email"`
+	Password  *string        `gorm:"type:varchar(255)" db:"-"` // Hashed password for traditional login
+	Role      Role           `gorm:"type:Role;default:'User'" db:"role"`
+	CreatedAt time.Time      `gorm:"autoCreateTime" db:"created_at"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime" db:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" db:"deleted_at"`
 }
@@ -11,4 +11,9 @@ type OpenSearchRepository interface {
 
 	CreateOrUpdateOrganization(event *models.OrganizationDocument) error
 	DeleteOrganization(event models.OrganizationDocument) error
+
+	// Bulk sends operations in one _bulk request and returns the error of each, in order
+	Bulk(operations []models.DocumentOperation) []error
+	// UpdateByQuery runs update on the documents of index it matches
+	UpdateByQuery(index string, update models.DocumentUpdate) error
 }
@@ -4,11 +4,13 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/http"
 
 	"github.com/DAF-Bridge/cdc-service/errs"
 	"github.com/DAF-Bridge/cdc-service/internal/models"
 	"github.com/DAF-Bridge/cdc-service/pkg/logs"
 	"github.com/opensearch-project/opensearch-go"
+	"github.com/opensearch-project/opensearch-go/opensearchapi"
 )
 
 type openSearchRepository struct {
@@ -31,9 +33,12 @@ func (os *openSearchRepository) CreateOrUpdateEvent(event *models.EventDocument)
 	// Index the document
 	res, err := os.es.Index("events", bytes.NewReader(data), os.es.Index.WithDocumentID(fmt.Sprintf("%d", event.ID)))
 	if err != nil {
-		return errs.NewCannotBeProcessedError("error indexing document")
+		return errs.NewUnavailableError(fmt.Sprintf("error indexing document: %v", err))
 	}
 	defer res.Body.Close()
+	if err := checkResponse(res, "indexing"); err != nil {
+		return err
+	}
 
 	logs.Info(fmt.Sprintf("Event document indexed: %v", event))
 
@@ -44,9 +49,12 @@ func (os *openSearchRepository) DeleteEvent(event models.EventDocument) error {
 	// Delete the document from OpenSearch
 	res, err := os.es.Delete("events", fmt.Sprintf("%d", event.ID))
 	if err != nil {
-		return errs.NewCannotBeProcessedError(fmt.Sprintf("error deleting document: %v", err))
+		return errs.NewUnavailableError(fmt.Sprintf("error deleting document: %v", err))
 	}
 	defer res.Body.Close()
+	if err := checkResponse(res, "deleting"); err != nil {
+		return err
+	}
 
 	logs.Info(fmt.Sprintf("Event document deleted: %v", event))
 
@@ -63,9 +71,12 @@ func (os *openSearchRepository) CreateOrUpdateJob(event *models.JobDocument) err
 	// Index the document
 	res, err := os.es.Index("jobs", bytes.NewReader(data), os.es.Index.WithDocumentID(fmt.Sprintf("%d", event.ID)))
 	if err != nil {
-		return errs.NewCannotBeProcessedError("error indexing document")
+		return errs.NewUnavailableError(fmt.Sprintf("error indexing document: %v", err))
 	}
 	defer res.Body.Close()
+	if err := checkResponse(res, "indexing"); err != nil {
+		return err
+	}
 
 	logs.Info(fmt.Sprintf("Job document indexed: %v", event))
 
@@ -76,9 +87,12 @@ func (os *openSearchRepository) DeleteJob(event models.JobDocument) error {
 	// Delete the document from OpenSearch
 	res, err := os.es.Delete("jobs", fmt.Sprintf("%d", event.ID))
 	if err != nil {
-		return errs.NewCannotBeProcessedError(fmt.Sprintf("error deleting document: %v", err))
+		return errs.NewUnavailableError(fmt.Sprintf("error deleting document: %v", err))
 	}
 	defer res.Body.Close()
+	if err := checkResponse(res, "deleting"); err != nil {
+		return err
+	}
 
 	logs.Info(fmt.Sprintf("Job document deleted: %v", event))
 
@@ -93,11 +107,14 @@ func (os *openSearchRepository) CreateOrUpdateOrganization(event *models.Organiz
 	}
 
 	// Index the document
-	res, err := os.es.Index("organization", bytes.NewReader(data), os.es.Index.WithDocumentID(fmt.Sprintf("%d", event.ID)))
+	res, err := os.es.Index("organizations", bytes.NewReader(data), os.es.Index.WithDocumentID(fmt.Sprintf("%d", event.ID)))
 	if err != nil {
-		return errs.NewCannotBeProcessedError("error indexing document")
+		return errs.NewUnavailableError(fmt.Sprintf("error indexing document: %v", err))
 	}
 	defer res.Body.Close()
+	if err := checkResponse(res, "indexing"); err != nil {
+		return err
+	}
 
 	logs.Info(fmt.Sprintf("Organization document indexed: %v", event))
 
@@ -106,17 +123,174 @@ func (os *openSearchRepository) CreateOrUpdateOrganization(event *models.Organiz
 
 func (os *openSearchRepository) DeleteOrganization(event models.OrganizationDocument) error {
 	// Delete the document from OpenSearch
-	res, err := os.es.Delete("organization", fmt.Sprintf("%d", event.ID))
+	res, err := os.es.Delete("organizations", fmt.Sprintf("%d", event.ID))
 	if err != nil {
-		return errs.NewCannotBeProcessedError(fmt.Sprintf("error deleting document: %v", err))
+		return errs.NewUnavailableError(fmt.Sprintf("error deleting document: %v", err))
 	}
 	defer res.Body.Close()
+	if err := checkResponse(res, "deleting"); err != nil {
+		return err
+	}
 
 	logs.Info(fmt.Sprintf("Organization document deleted: %v", event))
 
 	return nil
 }
 
+type bulkResponse struct {
+	Items []map[string]struct {
+		Status int             `json:"status"`
+		Error  json.RawMessage `json:"error"`
+	} `json:"items"`
+}
+
+func (os *openSearchRepository) Bulk(operations []models.DocumentOperation) []error {
+	results := make([]error, len(operations))
+	failAll := func(err error) []error {
+		for i := range results {
+			if results[i] == nil {
+				results[i] = err
+			}
+		}
+		return results
+	}
+
+	// The body is a list of action lines, each index action followed by its document
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	for i, operation := range operations {
+		meta := map[string]interface{}{"_index": operation.Index, "_id": fmt.Sprintf("%d", operation.ID)}
+		// external_gte lets a redelivered change write again, only older changes are rejected
+		if operation.Version > 0 {
+			meta["version"] = operation.Version
+			meta["version_type"] = "external_gte"
+		}
+		if operation.Document == nil {
+			_ = encoder.Encode(map[string]interface{}{"delete": meta})
+			continue
+		}
+
+		data, err := json.Marshal(operation.Document)
+		if err != nil {
+			results[i] = errs.NewCannotBeProcessedError(fmt.Sprintf("error marshalling document %s", operation.Key()))
+			continue
+		}
+		_ = encoder.Encode(map[string]interface{}{"index": meta})
+		body.Write(data)
+		body.WriteByte('\n')
+	}
+	if body.Len() == 0 {
+		return results
+	}
+
+	res, err := os.es.Bulk(bytes.NewReader(body.Bytes()))
+	if err != nil {
+		return failAll(errs.NewUnavailableError(fmt.Sprintf("error sending bulk request: %v", err)))
+	}
+	defer res.Body.Close()
+	if err := checkResponse(res, "writing"); err != nil {
+		return failAll(err)
+	}
+
+	var response bulkResponse
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return failAll(errs.NewUnavailableError(fmt.Sprintf("error decoding bulk response: %v", err)))
+	}
+
+	// Items come back in the order of the actions, which skip the operations that failed to marshal
+	item := 0
+	for i, operation := range operations {
+		if results[i] != nil {
+			continue
+		}
+		if item >= len(response.Items) {
+			results[i] = errs.NewUnavailableError(fmt.Sprintf("no bulk result for document %s", operation.Key()))
+			continue
+		}
+
+		for action, result := range response.Items[item] {
+			results[i] = checkBulkItem(action, result.Status, result.Error, operation)
+		}
+		item++
+	}
+
+	return results
+}
+
+// checkBulkItem applies the rules of checkResponse to one item of a _bulk response
+func checkBulkItem(action string, status int, cause json.RawMessage, operation models.DocumentOperation) error {
+	if status < http.StatusMultipleChoices || (action == "delete" && status == http.StatusNotFound) {
+		logs.Info(fmt.Sprintf("Document %s: %s", operation.Key(), action))
+		return nil
+	}
+
+	message := fmt.Sprintf("error writing document %s: %d %s", operation.Key(), status, string(cause))
+	// The document already has a newer version than the change
+	if status == http.StatusConflict {
+		return errs.NewConflictError(message)
+	}
+	if status == http.StatusTooManyRequests || status >= http.StatusInternalServerError {
+		return errs.NewUnavailableError(message)
+	}
+	return errs.NewCannotBeProcessedError(message)
+}
+
+func (os *openSearchRepository) UpdateByQuery(index string, update models.DocumentUpdate) error {
+	data, err := json.Marshal(map[string]interface{}{
+		"query": update.Query,
+		"script": map[string]interface{}{
+			"source": update.Script,
+			"lang":   "painless",
+			"params": update.Params,
+		},
+	})
+	if err != nil {
+		return errs.NewCannotBeProcessedError(fmt.Sprintf("error marshalling update of %s", update.Name))
+	}
+
+	// A document written at the same time already has the new data, the conflict is not an error
+	res, err := os.es.UpdateByQuery([]string{index},
+		os.es.UpdateByQuery.WithBody(bytes.NewReader(data)),
+		os.es.UpdateByQuery.WithConflicts("proceed"))
+	if err != nil {
+		return errs.NewUnavailableError(fmt.Sprintf("error updating documents: %v", err))
+	}
+	defer res.Body.Close()
+	if err := checkResponse(res, "updating"); err != nil {
+		return err
+	}
+
+	var response struct {
+		Updated  int               `json:"updated"`
+		Failures []json.RawMessage `json:"failures"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return errs.NewUnavailableError(fmt.Sprintf("error decoding update response: %v", err))
+	}
+	// Running the update again is harmless, the documents already updated are left as they are
+	if len(response.Failures) > 0 {
+		return errs.NewUnavailableError(fmt.Sprintf("error updating %s of %s: %s", update.Name, index, string(response.Failures[0])))
+	}
+
+	logs.Info(fmt.Sprintf("Updated %d documents of %s for %s", response.Updated, index, update.Name))
+
+	return nil
+}
+
+// checkResponse turns an OpenSearch error response into an error. 429 and 5xx are worth retrying,
+// any other error means the request itself is wrong. A document already gone is fine to delete.
+func checkResponse(res *opensearchapi.Response, action string) error {
+	if !res.IsError() || (action == "deleting" && res.StatusCode == http.StatusNotFound) {
+		return nil
+	}
+
+	message := fmt.Sprintf("error %s document: %s", action, res.String())
+	if res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= http.StatusInternalServerError {
+		return errs.NewUnavailableError(message)
+	}
+	return errs.NewCannotBeProcessedError(message)
+}
+
 // func (os *openSearchRepository) CreateOrUpdateEvent(event models.CDCEvent) error {
 // 	indexName := event.Payload.Source.Table
 // 	if indexName != "events" && indexName != "jobs" && indexName != "organization" {
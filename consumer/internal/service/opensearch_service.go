@@ -3,6 +3,8 @@ package service
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/DAF-Bridge/cdc-service/errs"
 	"github.com/DAF-Bridge/cdc-service/internal/dto"
@@ -28,133 +30,264 @@ func NewOpenSearchService(opnRepo repository.OpenSearchRepository, jobRepo repos
 	}
 }
 
-func (s *OpenSearchService) ProcessEvent(event models.CDCEvent) error {
-	var document interface{}
-	var err error
+// Search index of each table the consumer follows
+var searchIndexes = map[string]string{
+	"events":        "events",
+	"org_open_jobs": "jobs",
+	"organizations": "organizations",
+}
+
+// dependentTable is a table whose rows are copied into the documents of its parent table
+type dependentTable struct {
+	parent string
+	// column holds the ID of the parent row
+	column string
+}
+
+var dependentTables = map[string]dependentTable{
+	"category_event": {parent: "events", column: "event_id"},
+	"category_job":   {parent: "org_open_jobs", column: "org_open_job_id"},
+	"prerequisites":  {parent: "org_open_jobs", column: "job_id"},
+}
+
+// DocumentOperations converts a change of a row into the writes to the search documents holding
+// it. The consumer batches them into _bulk requests. Changes of other tables have no operation.
+func (s *OpenSearchService) DocumentOperations(event models.CDCEvent) ([]models.DocumentOperation, error) {
+	table := event.Payload.Source.Table
+	if dependent, ok := dependentTables[table]; ok {
+		return s.parentOperations(event, dependent)
+	}
+	if table == "categories" {
+		return categoryOperations(event)
+	}
+
+	index, ok := searchIndexes[table]
+	if !ok {
+		return nil, nil // Ignore other tables
+	}
+
+	id, ok := changedRow(event)["id"].(float64)
+	if !ok {
+		return nil, errs.NewCannotBeProcessedError(fmt.Sprintf("expected id to be a float64, got %T", changedRow(event)["id"]))
+	}
+	operation := models.DocumentOperation{Index: index, ID: uint(id), Version: event.Version()}
 
-	// -------------------------------
-	// Convert the event from kafka to a
-	// -------------------------------
 	switch event.Payload.Op {
 	case "d":
-		// Delete the document
-		switch event.Payload.Source.Table {
-		case "events":
-			logs.Info("Deleting event")
-			document = &models.EventDocument{
-				ID: uint(event.Payload.Before["id"].(float64)),
-			}
-		case "org_open_jobs":
-			logs.Info("Deleting job")
-			document = &models.JobDocument{
-				ID: uint(event.Payload.Before["id"].(float64)),
-			}
-		case "organization":
-			logs.Info("Deleting organization")
-			document = &models.OrganizationDocument{
-				ID: uint(event.Payload.Before["id"].(float64)),
-			}
+		logs.Info(fmt.Sprintf("Deleting %s %d", table, operation.ID))
+		if table == "organizations" {
+			return append([]models.DocumentOperation{operation}, organizationContentDeletes(operation.ID)...), nil
 		}
+		return []models.DocumentOperation{operation}, nil
 	case "c", "u":
-		switch event.Payload.Source.Table {
-		case "events":
-			logs.Info("Processing event")
-			if isSoftDelete(event.Payload.After) {
-				document = &models.EventDocument{
-					ID: uint(event.Payload.After["id"].(float64)),
-				}
-
-				break
-			}
-			document, err = s.convertToEventDocument(event)
-			if err != nil {
-				logs.Error(fmt.Sprintf("Error converting event to document: %v", err))
-			}
-		case "org_open_jobs":
-			logs.Info("Processing jobs")
-			if isSoftDelete(event.Payload.After) {
-				document = &models.JobDocument{
-					ID: uint(event.Payload.After["id"].(float64)),
-				}
-
-				break
+		if isRemovedFromSearch(table, event.Payload.After) {
+			logs.Info(fmt.Sprintf("Removing %s %d from search", table, operation.ID))
+			if table == "organizations" {
+				return append([]models.DocumentOperation{operation}, organizationContentDeletes(operation.ID)...), nil
 			}
-			document, err = s.convertToJobDocument(event)
-			if err != nil {
-				logs.Error(fmt.Sprintf("Error converting job to document: %v", err))
-			}
-		case "organization":
-			logs.Info("Processing organization")
-			if isSoftDelete(event.Payload.After) {
-				document = &models.OrganizationDocument{
-					ID: uint(event.Payload.After["id"].(float64)),
-				}
-
-				break
-			}
-			document, err = s.convertToOrganizationDocument(event)
-			if err != nil {
-				logs.Error(fmt.Sprintf("Error converting organization to document: %v", err))
-			}
-		default:
-			return nil // Ignore other tables
+			return []models.DocumentOperation{operation}, nil
 		}
+	default:
+		return nil, nil
 	}
 
+	logs.Info(fmt.Sprintf("Processing %s %d", table, operation.ID))
+	if table == "organizations" {
+		return s.organizationOperations(operation, event.Payload.Op == "u")
+	}
+
+	operation, err := s.reindexOperation(table, operation.ID, operation.Version)
 	if err != nil {
-		return errs.NewCannotBeProcessedError("error converting event to document")
+		return nil, err
 	}
+	return []models.DocumentOperation{operation}, nil
+}
 
-	// -------------------------------
-	// Process the document
-	// -------------------------------
+// parentOperations reindexes the parent of a dependent row, e.g. the job of a prerequisite, from
+// the database
+func (s *OpenSearchService) parentOperations(event models.CDCEvent, dependent dependentTable) ([]models.DocumentOperation, error) {
 	switch event.Payload.Op {
-	case "c", "u":
-		switch doc := document.(type) {
-		case *models.EventDocument:
-			if isSoftDelete(event.Payload.After) {
-				document = &models.OrganizationDocument{
-					ID: uint(event.Payload.After["id"].(float64)),
-				}
-				return s.opnRepo.DeleteEvent(*doc)
-			} else {
-				return s.opnRepo.CreateOrUpdateEvent(doc)
-			}
-		case *models.JobDocument:
-			if isSoftDelete(event.Payload.After) {
-				document = &models.JobDocument{
-					ID: uint(event.Payload.After["id"].(float64)),
-				}
-				return s.opnRepo.DeleteJob(*doc)
-			} else {
-				return s.opnRepo.CreateOrUpdateJob(doc)
-			}
-		case *models.OrganizationDocument:
-			if isSoftDelete(event.Payload.After) {
-				document = &models.OrganizationDocument{
-					ID: uint(event.Payload.After["id"].(float64)),
-				}
-				return s.opnRepo.DeleteOrganization(*doc)
-			} else {
-				return s.opnRepo.CreateOrUpdateOrganization(doc)
-			}
-		default:
-			return errs.NewCannotBeProcessedError("unknown document type")
+	case "c", "u", "d":
+	default:
+		return nil, nil
+	}
+
+	table := event.Payload.Source.Table
+	parentID, ok := changedRow(event)[dependent.column].(float64)
+	if !ok {
+		// A hard delete only carries the primary key unless the table has REPLICA IDENTITY FULL
+		logs.Warn(fmt.Sprintf("No %s in the change of %s, its %s cannot be reindexed", dependent.column, table, dependent.parent))
+		return nil, nil
+	}
+
+	logs.Info(fmt.Sprintf("Reindexing %s %d after a change of %s", dependent.parent, uint(parentID), table))
+	operation, err := s.reindexOperation(dependent.parent, uint(parentID), event.Version())
+	if err != nil {
+		return nil, err
+	}
+	return []models.DocumentOperation{operation}, nil
+}
+
+// reindexOperation writes the document of an event or job from the database. The document is
+// deleted instead when the row is gone or must not be searchable: hidden by moderation, or owned
+// by a suspended organization.
+func (s *OpenSearchService) reindexOperation(table string, id uint, version int64) (models.DocumentOperation, error) {
+	operation := models.DocumentOperation{Index: searchIndexes[table], ID: id, Version: version}
+
+	var err error
+	switch table {
+	case "events":
+		var eventData *models.Event
+		eventData, err = s.getEventByID(id)
+		if err == nil && isSearchable(eventData.HiddenAt, eventData.Organization) {
+			operation.Document = newEventDocument(eventData)
 		}
-	case "d":
-		switch doc := document.(type) {
-		case *models.EventDocument:
-			return s.opnRepo.DeleteEvent(*doc)
-		case *models.JobDocument:
-			return s.opnRepo.DeleteJob(*doc)
-		case *models.OrganizationDocument:
-			return s.opnRepo.DeleteOrganization(*doc)
-		default:
-			return errs.NewCannotBeProcessedError("unknown document type")
+	case "org_open_jobs":
+		var jobData *models.OrgOpenJob
+		jobData, err = s.getJobByID(id)
+		if err == nil && isSearchable(jobData.HiddenAt, jobData.Organization) {
+			operation.Document = newJobDocument(jobData)
+		}
+	}
+	if err != nil {
+		if isNotFound(err) {
+			// Deleted since the change, its document goes as well
+			logs.Info(fmt.Sprintf("%s %d no longer exists, removing it from search", table, id))
+			return operation, nil
 		}
+		return operation, conversionError(table, err)
+	}
+
+	return operation, nil
+}
+
+// organizationOperations writes the document of an organization from the database and, for an
+// update, refreshes its copies in its events and jobs. A deleted or suspended organization is
+// removed from search along with its events and jobs; one not approved keeps its document, which
+// organization search filters by status, but not its events and jobs.
+func (s *OpenSearchService) organizationOperations(operation models.DocumentOperation, update bool) ([]models.DocumentOperation, error) {
+	orgData, err := s.getOrganizationByID(operation.ID)
+	if err != nil && !isNotFound(err) {
+		return nil, conversionError("organizations", err)
+	}
+	if err != nil || orgData.Status == models.OrgStatusSuspended {
+		logs.Info(fmt.Sprintf("Organization %d is deleted or suspended, removing it from search", operation.ID))
+		return append([]models.DocumentOperation{operation}, organizationContentDeletes(operation.ID)...), nil
+	}
+
+	org := newOrganizationDocument(orgData)
+	operation.Document = org
+	if orgData.Status != models.OrgStatusApproved {
+		logs.Info(fmt.Sprintf("Organization %d is not approved, removing its events and jobs from search", operation.ID))
+		return append([]models.DocumentOperation{operation}, organizationContentDeletes(operation.ID)...), nil
+	}
+	if !update {
+		return []models.DocumentOperation{operation}, nil
+	}
+	return append([]models.DocumentOperation{operation}, organizationCopyUpdates(org, organizationVersion(*orgData))...), nil
+}
+
+// organizationCopyUpdates refreshes the name and picture of org copied into its events and jobs.
+// Copies carry the version of the organization they were taken from, so an update applied late
+// cannot bring back an older name over a newer one.
+func organizationCopyUpdates(org *models.OrganizationDocument, version int64) []models.DocumentOperation {
+	update := &models.DocumentUpdate{
+		Name:  fmt.Sprintf("organization %d", org.ID),
+		Query: map[string]interface{}{"term": map[string]interface{}{"organization.id": org.ID}},
+		Script: "def copy = ctx._source.organization; " +
+			"long version = copy.version == null ? 0L : ((Number) copy.version).longValue(); " +
+			"if (version > params.version || (copy.name == params.name && copy.picUrl == params.picUrl)) { ctx.op = 'noop' } " +
+			"else { copy.name = params.name; copy.picUrl = params.picUrl; copy.version = params.version }",
+		Params: map[string]interface{}{"name": org.Name, "picUrl": org.PicUrl, "version": version},
+	}
+
+	return []models.DocumentOperation{
+		{Index: "events", ID: org.ID, Update: update},
+		{Index: "jobs", ID: org.ID, Update: update},
+	}
+}
+
+// organizationContentDeletes removes the events and jobs of an organization from search
+func organizationContentDeletes(orgID uint) []models.DocumentOperation {
+	update := &models.DocumentUpdate{
+		Name:   fmt.Sprintf("content of organization %d", orgID),
+		Query:  map[string]interface{}{"term": map[string]interface{}{"organization.id": orgID}},
+		Script: "ctx.op = 'delete'",
+	}
+
+	return []models.DocumentOperation{
+		{Index: "events", ID: orgID, Update: update},
+		{Index: "jobs", ID: orgID, Update: update},
+	}
+}
+
+// categoryOperations renames a category in the events and jobs it is copied into, or takes it out
+// of them once soft deleted. A hard delete removes the category_event and category_job rows first,
+// which reindexes their parents.
+func categoryOperations(event models.CDCEvent) ([]models.DocumentOperation, error) {
+	if event.Payload.Op != "u" {
+		return nil, nil
+	}
+
+	id, ok := event.Payload.After["id"].(float64)
+	if !ok {
+		return nil, errs.NewCannotBeProcessedError(fmt.Sprintf("expected id to be a float64, got %T", event.Payload.After["id"]))
+	}
+	name, _ := event.Payload.After["name"].(string)
+
+	// Painless compares boxed numbers of different types as unequal, the IDs are compared as strings
+	script := "boolean changed = false; for (def category : ctx._source.categories) { " +
+		"if (String.valueOf(category.value) == params.id && category.label != params.name) { category.label = params.name; changed = true } } " +
+		"if (!changed) { ctx.op = 'noop' }"
+	if isSoftDelete(event.Payload.After) {
+		script = "if (!ctx._source.categories.removeIf(category -> String.valueOf(category.value) == params.id)) { ctx.op = 'noop' }"
+	}
+
+	update := &models.DocumentUpdate{
+		Name:   fmt.Sprintf("category %d", uint(id)),
+		Query:  map[string]interface{}{"term": map[string]interface{}{"categories.value": uint(id)}},
+		Script: script,
+		Params: map[string]interface{}{"id": fmt.Sprintf("%d", uint(id)), "name": name},
 	}
+	logs.Info(fmt.Sprintf("Updating %s in events and jobs", update.Name))
 
-	return nil
+	return []models.DocumentOperation{
+		{Index: "events", ID: uint(id), Update: update},
+		{Index: "jobs", ID: uint(id), Update: update},
+	}, nil
+}
+
+// OrderingKey is the row whose document a change writes: the parent row for a dependent table.
+// Changes with the same key must be applied in order. It is empty when the change has no such row.
+func OrderingKey(event models.CDCEvent) string {
+	table := event.Payload.Source.Table
+	column := "id"
+	if dependent, ok := dependentTables[table]; ok {
+		table, column = dependent.parent, dependent.column
+	}
+
+	id, ok := changedRow(event)[column]
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%s/%v", table, id)
+}
+
+// changedRow is the row after the change, or before it for a delete
+func changedRow(event models.CDCEvent) map[string]interface{} {
+	if event.Payload.Op == "d" {
+		return event.Payload.Before
+	}
+	return event.Payload.After
+}
+
+func conversionError(table string, err error) error {
+	logs.Error(fmt.Sprintf("Error converting %s to document: %v", table, err))
+	// The database being unavailable is worth a retry, unlike a payload that cannot be converted
+	if errs.IsTransient(err) {
+		return err
+	}
+	return errs.NewCannotBeProcessedError("error converting event to document")
 }
 
 func isSoftDelete(after map[string]interface{}) bool {
@@ -164,18 +297,44 @@ func isSoftDelete(after map[string]interface{}) bool {
 	return false
 }
 
-func (s *OpenSearchService) convertToEventDocument(event models.CDCEvent) (*models.EventDocument, error) {
-	id, ok := event.Payload.After["id"].(float64)
-	if !ok {
-		return nil, fmt.Errorf("expected id to be a float64, got %T", event.Payload.After["id"])
+// isRemovedFromSearch reports whether the row must be taken out of search: soft deleted rows,
+// events and jobs hidden by moderation and suspended organizations
+func isRemovedFromSearch(table string, after map[string]interface{}) bool {
+	if isSoftDelete(after) {
+		return true
 	}
 
-	eventData, err := s.getEventByID(uint(id))
-	if err != nil {
-		logs.Error(fmt.Sprintf("Error fetching event data: %v", err))
-		return nil, err
+	switch table {
+	case "events", "org_open_jobs":
+		if hiddenAt, exists := after["hidden_at"]; exists && hiddenAt != nil {
+			return true
+		}
+	case "organizations":
+		if status, ok := after["status"].(string); ok && status == models.OrgStatusSuspended {
+			return true
+		}
 	}
+	return false
+}
+
+// isSearchable reports whether an event or job read from the database belongs in search: it is
+// not hidden and its organization is approved. A soft deleted organization is not loaded, which
+// leaves org empty.
+func isSearchable(hiddenAt *time.Time, org models.Organization) bool {
+	return hiddenAt == nil && org.ID != 0 && org.Status == models.OrgStatusApproved
+}
+
+func isNotFound(err error) bool {
+	var appErr errs.AppError
+	return errors.As(err, &appErr) && appErr.Code == http.StatusNotFound
+}
+
+// organizationVersion orders the copies of an organization in its events and jobs
+func organizationVersion(org models.Organization) int64 {
+	return org.UpdatedAt.UnixMicro()
+}
 
+func newEventDocument(eventData *models.Event) *models.EventDocument {
 	var categories []dto.CategoryRequest
 	for _, category := range eventData.Categories {
 		categories = append(categories, dto.CategoryRequest{
@@ -189,33 +348,32 @@ func (s *OpenSearchService) convertToEventDocument(event models.CDCEvent) (*mode
 		endDate = eventData.EndDate.Format("2006-01-02")
 	}
 
-	eventDoc := &models.EventDocument{
-		ID:           uint(id),
-		Name:         event.Payload.After["name"].(string),
-		PicUrl:       event.Payload.After["pic_url"].(string),
-		Content:      event.Payload.After["content"].(string),
+	return &models.EventDocument{
+		ID:           eventData.ID,
+		Name:         eventData.Name,
+		PicUrl:       eventData.PicUrl,
+		Content:      eventData.Content,
 		Latitude:     eventData.Latitude,
 		Longitude:    eventData.Longitude,
 		StartDate:    eventData.StartDate.Format("2006-01-02"),
 		EndDate:      endDate,
 		StartTime:    eventData.StartTime.Format("15:04:05"),
 		EndTime:      eventData.EndTime.Format("15:04:05"),
-		LocationName: event.Payload.After["location_name"].(string),
-		Province:     event.Payload.After["province"].(string),
-		Country:      event.Payload.After["country"].(string),
-		LocationType: event.Payload.After["location_type"].(string),
+		LocationName: eventData.LocationName,
+		Province:     eventData.Province,
+		Country:      eventData.Country,
+		LocationType: eventData.LocationType,
 		Organization: models.OrganizationShortDocument{
-			ID:     eventData.Organization.ID,
-			Name:   eventData.Organization.Name,
-			PicUrl: eventData.Organization.PicUrl,
+			ID:      eventData.Organization.ID,
+			Name:    eventData.Organization.Name,
+			PicUrl:  eventData.Organization.PicUrl,
+			Version: organizationVersion(eventData.Organization),
 		},
 		Categories: categories,
-		Audience:   event.Payload.After["audience"].(string),
-		Price:      event.Payload.After["price_type"].(string),
+		Audience:   eventData.Audience,
+		Price:      eventData.PriceType,
 		UpdateAt:   eventData.UpdatedAt.Format("2006-01-02 15:04:05"),
 	}
-
-	return eventDoc, nil
 }
 
 func (s *OpenSearchService) getEventByID(id uint) (*models.Event, error) {
@@ -226,24 +384,13 @@ func (s *OpenSearchService) getEventByID(id uint) (*models.Event, error) {
 		}
 
 		logs.Error(fmt.Sprintf("Error fetching event: %v", err))
-		return nil, err
+		return nil, errs.NewUnavailableError(fmt.Sprintf("error fetching event: %v", err))
 	}
 
 	return event, nil
 }
 
-func (s *OpenSearchService) convertToJobDocument(event models.CDCEvent) (*models.JobDocument, error) {
-	id, ok := event.Payload.After["id"].(float64)
-	if !ok {
-		return nil, fmt.Errorf("expected id to be a float64, got %T", event.Payload.After["id"])
-	}
-
-	jobData, err := s.getJobByID(uint(id))
-	if err != nil {
-		logs.Error(fmt.Sprintf("Error fetching job data: %v", err))
-		return nil, err
-	}
-
+func newJobDocument(jobData *models.OrgOpenJob) *models.JobDocument {
 	var categories []dto.CategoryRequest
 	for _, category := range jobData.Categories {
 		categories = append(categories, dto.CategoryRequest{
@@ -260,27 +407,26 @@ func (s *OpenSearchService) convertToJobDocument(event models.CDCEvent) (*models
 		})
 	}
 
-	jobDoc := &models.JobDocument{
-		ID:            uint(id),
-		Title:         event.Payload.After["title"].(string),
+	return &models.JobDocument{
+		ID:            jobData.ID,
+		Title:         jobData.Title,
 		Prerequisites: prerequisites,
-		Description:   event.Payload.After["description"].(string),
-		Workplace:     event.Payload.After["workplace"].(string),
-		WorkType:      event.Payload.After["work_type"].(string),
-		CareerStage:   event.Payload.After["career_stage"].(string),
+		Description:   jobData.Description,
+		Workplace:     string(jobData.Workplace),
+		WorkType:      string(jobData.WorkType),
+		CareerStage:   string(jobData.CareerStage),
 		Salary:        jobData.Salary,
 		Categories:    categories,
 		Organization: models.OrganizationShortDocument{
-			ID:     jobData.Organization.ID,
-			Name:   jobData.Organization.Name,
-			PicUrl: jobData.Organization.PicUrl,
+			ID:      jobData.Organization.ID,
+			Name:    jobData.Organization.Name,
+			PicUrl:  jobData.Organization.PicUrl,
+			Version: organizationVersion(jobData.Organization),
 		},
-		Province: event.Payload.After["province"].(string),
-		Country:  event.Payload.After["country"].(string),
+		Province: jobData.Province,
+		Country:  jobData.Country,
 		UpdateAt: jobData.UpdatedAt.Format("2006-01-02 15:04:05"),
 	}
-
-	return jobDoc, nil
 }
 
 func (s *OpenSearchService) getJobByID(id uint) (*models.OrgOpenJob, error) {
@@ -291,39 +437,34 @@ func (s *OpenSearchService) getJobByID(id uint) (*models.OrgOpenJob, error) {
 		}
 
 		logs.Error(fmt.Sprintf("Error fetching job data: %v", err))
-		return nil, err
+		return nil, errs.NewUnavailableError(fmt.Sprintf("error fetching job: %v", err))
 	}
 
 	return job, nil
 }
 
-func (s *OpenSearchService) convertToOrganizationDocument(event models.CDCEvent) (*models.OrganizationDocument, error) {
-	id, ok := event.Payload.After["id"].(float64)
-	if !ok {
-		return nil, fmt.Errorf("expected id to be a float64, got %T", event.Payload.After["id"])
+func newOrganizationDocument(orgData *models.Organization) *models.OrganizationDocument {
+	var industries []string
+	for _, industry := range orgData.Industries {
+		industries = append(industries, industry.Industry)
 	}
 
-	orgData, err := s.getOrganizationByID(uint(id))
-	if err != nil {
-		logs.Error(fmt.Sprintf("Error fetching organization data: %v", err))
-		return nil, err
-	}
-
-	orgDoc := &models.OrganizationDocument{
-		ID:          uint(id),
-		Name:        event.Payload.After["org_name"].(string),
-		PicUrl:      event.Payload.After["pic_url"].(string),
-		Description: event.Payload.After["description"].(string),
+	return &models.OrganizationDocument{
+		ID:          orgData.ID,
+		Name:        orgData.Name,
+		PicUrl:      orgData.PicUrl,
+		HeadLine:    orgData.HeadLine,
+		Description: orgData.Description,
 		Latitude:    orgData.Latitude,
 		Longitude:   orgData.Longitude,
-		Email:       event.Payload.After["email"].(string),
-		Phone:       event.Payload.After["phone"].(string),
-		Province:    event.Payload.After["province"].(string),
-		Country:     event.Payload.After["country"].(string),
+		Email:       orgData.Email,
+		Phone:       orgData.Phone,
+		Province:    orgData.Province,
+		Country:     orgData.Country,
+		Industries:  industries,
+		Status:      orgData.Status,
 		UpdateAt:    orgData.UpdatedAt.Format("2006-01-02 15:04:05"),
 	}
-
-	return orgDoc, nil
 }
 
 func (s *OpenSearchService) getOrganizationByID(id uint) (*models.Organization, error) {
@@ -334,7 +475,7 @@ func (s *OpenSearchService) getOrganizationByID(id uint) (*models.Organization,
 		}
 
 		logs.Error(fmt.Sprintf("Error fetching organization data: %v", err))
-		return nil, err
+		return nil, errs.NewUnavailableError(fmt.Sprintf("error fetching organization: %v", err))
 	}
 
 	return org, nil